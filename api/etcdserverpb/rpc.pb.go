@@ -34,21 +34,24 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type AlarmType int32
 
 const (
-	AlarmType_NONE    AlarmType = 0
-	AlarmType_NOSPACE AlarmType = 1
-	AlarmType_CORRUPT AlarmType = 2
+	AlarmType_NONE           AlarmType = 0
+	AlarmType_NOSPACE        AlarmType = 1
+	AlarmType_CORRUPT        AlarmType = 2
+	AlarmType_SLOW_WAL_FSYNC AlarmType = 3
 )
 
 var AlarmType_name = map[int32]string{
 	0: "NONE",
 	1: "NOSPACE",
 	2: "CORRUPT",
+	3: "SLOW_WAL_FSYNC",
 }
 
 var AlarmType_value = map[string]int32{
-	"NONE":    0,
-	"NOSPACE": 1,
-	"CORRUPT": 2,
+	"NONE":           0,
+	"NOSPACE":        1,
+	"CORRUPT":        2,
+	"SLOW_WAL_FSYNC": 3,
 }
 
 func (x AlarmType) String() string {
@@ -6154,264 +6157,263 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 4107 bytes of a gzipped FileDescriptorProto
+	// 4092 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0x5b, 0x73, 0x1b, 0xc9,
-	0x75, 0xe6, 0x00, 0xc4, 0xed, 0xe0, 0x42, 0xb0, 0x79, 0x11, 0x84, 0x95, 0x28, 0x6e, 0x6b, 0xa5,
-	0xe5, 0x4a, 0xbb, 0xc4, 0x9a, 0xb6, 0xb3, 0x55, 0x4a, 0xe2, 0x18, 0x22, 0xb1, 0x12, 0x97, 0x14,
-	0xc9, 0x1d, 0x42, 0xda, 0x4b, 0xb9, 0xc2, 0x1a, 0x02, 0x2d, 0x72, 0x42, 0x60, 0x06, 0x9e, 0x19,
-	0x40, 0xe4, 0xe6, 0xe2, 0x94, 0xcb, 0x71, 0x25, 0xaf, 0x76, 0x55, 0x2a, 0x79, 0x48, 0x5e, 0x52,
-	0x29, 0x97, 0x1f, 0xfc, 0x9c, 0xbf, 0x90, 0xa7, 0x5c, 0x2a, 0x7f, 0x20, 0xb5, 0xf1, 0x4b, 0xf2,
-	0x23, 0x52, 0xae, 0xbe, 0xcd, 0xf4, 0xdc, 0x40, 0xd9, 0xd8, 0xdd, 0x17, 0x11, 0x7d, 0xfa, 0xf4,
-	0xf9, 0x4e, 0x9f, 0xee, 0x3e, 0xe7, 0xf4, 0xe9, 0x11, 0x94, 0x9c, 0x51, 0x6f, 0x73, 0xe4, 0xd8,
-	0x9e, 0x8d, 0x2a, 0xc4, 0xeb, 0xf5, 0x5d, 0xe2, 0x4c, 0x88, 0x33, 0x3a, 0x6d, 0x2e, 0x9f, 0xd9,
-	0x67, 0x36, 0xeb, 0x68, 0xd1, 0x5f, 0x9c, 0xa7, 0xd9, 0xa0, 0x3c, 0x2d, 0x63, 0x64, 0xb6, 0x86,
-	0x93, 0x5e, 0x6f, 0x74, 0xda, 0xba, 0x98, 0x88, 0x9e, 0xa6, 0xdf, 0x63, 0x8c, 0xbd, 0xf3, 0xd1,
-	0x29, 0xfb, 0x23, 0xfa, 0x6e, 0x9d, 0xd9, 0xf6, 0xd9, 0x80, 0xf0, 0x5e, 0xcb, 0xb2, 0x3d, 0xc3,
-	0x33, 0x6d, 0xcb, 0xe5, 0xbd, 0xf8, 0xaf, 0x34, 0xa8, 0xe9, 0xc4, 0x1d, 0xd9, 0x96, 0x4b, 0x9e,
-	0x12, 0xa3, 0x4f, 0x1c, 0x74, 0x1b, 0xa0, 0x37, 0x18, 0xbb, 0x1e, 0x71, 0x4e, 0xcc, 0x7e, 0x43,
-	0x5b, 0xd7, 0x36, 0xe6, 0xf5, 0x92, 0xa0, 0xec, 0xf6, 0xd1, 0x1b, 0x50, 0x1a, 0x92, 0xe1, 0x29,
-	0xef, 0xcd, 0xb0, 0xde, 0x22, 0x27, 0xec, 0xf6, 0x51, 0x13, 0x8a, 0x0e, 0x99, 0x98, 0xae, 0x69,
-	0x5b, 0x8d, 0xec, 0xba, 0xb6, 0x91, 0xd5, 0xfd, 0x36, 0x1d, 0xe8, 0x18, 0x2f, 0xbd, 0x13, 0x8f,
-	0x38, 0xc3, 0xc6, 0x3c, 0x1f, 0x48, 0x09, 0x5d, 0xe2, 0x0c, 0xf1, 0x4f, 0x72, 0x50, 0xd1, 0x0d,
-	0xeb, 0x8c, 0xe8, 0xe4, 0x87, 0x63, 0xe2, 0x7a, 0xa8, 0x0e, 0xd9, 0x0b, 0x72, 0xc5, 0xe0, 0x2b,
-	0x3a, 0xfd, 0xc9, 0xc7, 0x5b, 0x67, 0xe4, 0x84, 0x58, 0x1c, 0xb8, 0x42, 0xc7, 0x5b, 0x67, 0xa4,
-	0x63, 0xf5, 0xd1, 0x32, 0xe4, 0x06, 0xe6, 0xd0, 0xf4, 0x04, 0x2a, 0x6f, 0x84, 0xd4, 0x99, 0x8f,
-	0xa8, 0xb3, 0x0d, 0xe0, 0xda, 0x8e, 0x77, 0x62, 0x3b, 0x7d, 0xe2, 0x34, 0x72, 0xeb, 0xda, 0x46,
-	0x6d, 0xeb, 0xad, 0x4d, 0x75, 0x19, 0x36, 0x55, 0x85, 0x36, 0x8f, 0x6d, 0xc7, 0x3b, 0xa4, 0xbc,
-	0x7a, 0xc9, 0x95, 0x3f, 0xd1, 0x87, 0x50, 0x66, 0x42, 0x3c, 0xc3, 0x39, 0x23, 0x5e, 0x23, 0xcf,
-	0xa4, 0xdc, 0xbb, 0x46, 0x4a, 0x97, 0x31, 0xeb, 0x0c, 0x9e, 0xff, 0x46, 0x18, 0x2a, 0x2e, 0x71,
-	0x4c, 0x63, 0x60, 0x7e, 0x61, 0x9c, 0x0e, 0x48, 0xa3, 0xb0, 0xae, 0x6d, 0x14, 0xf5, 0x10, 0x8d,
-	0xce, 0xff, 0x82, 0x5c, 0xb9, 0x27, 0xb6, 0x35, 0xb8, 0x6a, 0x14, 0x19, 0x43, 0x91, 0x12, 0x0e,
-	0xad, 0xc1, 0x15, 0x5b, 0x34, 0x7b, 0x6c, 0x79, 0xbc, 0xb7, 0xc4, 0x7a, 0x4b, 0x8c, 0xc2, 0xba,
-	0x37, 0xa0, 0x3e, 0x34, 0xad, 0x93, 0xa1, 0xdd, 0x3f, 0xf1, 0x0d, 0x02, 0xcc, 0x20, 0xb5, 0xa1,
-	0x69, 0x3d, 0xb3, 0xfb, 0xba, 0x34, 0x0b, 0xe5, 0x34, 0x2e, 0xc3, 0x9c, 0x65, 0xc1, 0x69, 0x5c,
-	0xaa, 0x9c, 0x9b, 0xb0, 0x44, 0x65, 0xf6, 0x1c, 0x62, 0x78, 0x24, 0x60, 0xae, 0x30, 0xe6, 0xc5,
-	0xa1, 0x69, 0x6d, 0xb3, 0x9e, 0x10, 0xbf, 0x71, 0x19, 0xe3, 0xaf, 0x0a, 0x7e, 0xe3, 0x32, 0xcc,
-	0x8f, 0x37, 0xa1, 0xe4, 0xdb, 0x1c, 0x15, 0x61, 0xfe, 0xe0, 0xf0, 0xa0, 0x53, 0x9f, 0x43, 0x00,
-	0xf9, 0xf6, 0xf1, 0x76, 0xe7, 0x60, 0xa7, 0xae, 0xa1, 0x32, 0x14, 0x76, 0x3a, 0xbc, 0x91, 0xc1,
-	0x8f, 0x01, 0x02, 0xeb, 0xa2, 0x02, 0x64, 0xf7, 0x3a, 0x9f, 0xd5, 0xe7, 0x28, 0xcf, 0x8b, 0x8e,
-	0x7e, 0xbc, 0x7b, 0x78, 0x50, 0xd7, 0xe8, 0xe0, 0x6d, 0xbd, 0xd3, 0xee, 0x76, 0xea, 0x19, 0xca,
-	0xf1, 0xec, 0x70, 0xa7, 0x9e, 0x45, 0x25, 0xc8, 0xbd, 0x68, 0xef, 0x3f, 0xef, 0xd4, 0xe7, 0xf1,
-	0xcf, 0x35, 0xa8, 0x8a, 0xf5, 0xe2, 0x67, 0x02, 0x7d, 0x07, 0xf2, 0xe7, 0xec, 0x5c, 0xb0, 0xad,
-	0x58, 0xde, 0xba, 0x15, 0x59, 0xdc, 0xd0, 0xd9, 0xd1, 0x05, 0x2f, 0xc2, 0x90, 0xbd, 0x98, 0xb8,
-	0x8d, 0xcc, 0x7a, 0x76, 0xa3, 0xbc, 0x55, 0xdf, 0xe4, 0xe7, 0x75, 0x73, 0x8f, 0x5c, 0xbd, 0x30,
-	0x06, 0x63, 0xa2, 0xd3, 0x4e, 0x84, 0x60, 0x7e, 0x68, 0x3b, 0x84, 0xed, 0xd8, 0xa2, 0xce, 0x7e,
-	0xd3, 0x6d, 0xcc, 0x16, 0x4d, 0xec, 0x56, 0xde, 0xc0, 0xbf, 0xd4, 0x00, 0x8e, 0xc6, 0x5e, 0xfa,
-	0xd1, 0x58, 0x86, 0xdc, 0x84, 0x0a, 0x16, 0xc7, 0x82, 0x37, 0xd8, 0x99, 0x20, 0x86, 0x4b, 0xfc,
-	0x33, 0x41, 0x1b, 0xe8, 0x06, 0x14, 0x46, 0x0e, 0x99, 0x9c, 0x5c, 0x4c, 0x18, 0x48, 0x51, 0xcf,
-	0xd3, 0xe6, 0xde, 0x04, 0xbd, 0x09, 0x15, 0xf3, 0xcc, 0xb2, 0x1d, 0x72, 0xc2, 0x65, 0xe5, 0x58,
-	0x6f, 0x99, 0xd3, 0x98, 0xde, 0x0a, 0x0b, 0x17, 0x9c, 0x57, 0x59, 0xf6, 0x29, 0x09, 0x5b, 0x50,
-	0x66, 0xaa, 0xce, 0x64, 0xbe, 0x77, 0x02, 0x1d, 0x33, 0x6c, 0x58, 0xdc, 0x84, 0x42, 0x6b, 0xfc,
-	0x03, 0x40, 0x3b, 0x64, 0x40, 0x3c, 0x32, 0x8b, 0xf7, 0x50, 0x6c, 0x92, 0x55, 0x6d, 0x82, 0x7f,
-	0xa6, 0xc1, 0x52, 0x48, 0xfc, 0x4c, 0xd3, 0x6a, 0x40, 0xa1, 0xcf, 0x84, 0x71, 0x0d, 0xb2, 0xba,
-	0x6c, 0xa2, 0x87, 0x50, 0x14, 0x0a, 0xb8, 0x8d, 0x6c, 0xca, 0xa6, 0x29, 0x70, 0x9d, 0x5c, 0xfc,
-	0xcb, 0x0c, 0x94, 0xc4, 0x44, 0x0f, 0x47, 0xa8, 0x0d, 0x55, 0x87, 0x37, 0x4e, 0xd8, 0x7c, 0x84,
-	0x46, 0xcd, 0x74, 0x27, 0xf4, 0x74, 0x4e, 0xaf, 0x88, 0x21, 0x8c, 0x8c, 0x7e, 0x1f, 0xca, 0x52,
-	0xc4, 0x68, 0xec, 0x09, 0x93, 0x37, 0xc2, 0x02, 0x82, 0xfd, 0xf7, 0x74, 0x4e, 0x07, 0xc1, 0x7e,
-	0x34, 0xf6, 0x50, 0x17, 0x96, 0xe5, 0x60, 0x3e, 0x1b, 0xa1, 0x46, 0x96, 0x49, 0x59, 0x0f, 0x4b,
-	0x89, 0x2f, 0xd5, 0xd3, 0x39, 0x1d, 0x89, 0xf1, 0x4a, 0xa7, 0xaa, 0x92, 0x77, 0xc9, 0x9d, 0x77,
-	0x4c, 0xa5, 0xee, 0xa5, 0x15, 0x57, 0xa9, 0x7b, 0x69, 0x3d, 0x2e, 0x41, 0x41, 0xb4, 0xf0, 0xbf,
-	0x64, 0x00, 0xe4, 0x6a, 0x1c, 0x8e, 0xd0, 0x0e, 0xd4, 0x1c, 0xd1, 0x0a, 0x59, 0xeb, 0x8d, 0x44,
-	0x6b, 0x89, 0x45, 0x9c, 0xd3, 0xab, 0x72, 0x10, 0x57, 0xee, 0x7b, 0x50, 0xf1, 0xa5, 0x04, 0x06,
-	0xbb, 0x99, 0x60, 0x30, 0x5f, 0x42, 0x59, 0x0e, 0xa0, 0x26, 0xfb, 0x04, 0x56, 0xfc, 0xf1, 0x09,
-	0x36, 0x7b, 0x73, 0x8a, 0xcd, 0x7c, 0x81, 0x4b, 0x52, 0x82, 0x6a, 0x35, 0x55, 0xb1, 0xc0, 0x6c,
-	0x37, 0x13, 0xcc, 0x16, 0x57, 0x8c, 0x1a, 0x0e, 0x68, 0xbc, 0xe4, 0x4d, 0xfc, 0xbf, 0x59, 0x28,
-	0x6c, 0xdb, 0xc3, 0x91, 0xe1, 0xd0, 0xd5, 0xc8, 0x3b, 0xc4, 0x1d, 0x0f, 0x3c, 0x66, 0xae, 0xda,
-	0xd6, 0xdd, 0xb0, 0x44, 0xc1, 0x26, 0xff, 0xea, 0x8c, 0x55, 0x17, 0x43, 0xe8, 0x60, 0x11, 0x1e,
-	0x33, 0xaf, 0x31, 0x58, 0x04, 0x47, 0x31, 0x44, 0x1e, 0xe4, 0x6c, 0x70, 0x90, 0x9b, 0x50, 0x98,
-	0x10, 0x27, 0x08, 0xe9, 0x4f, 0xe7, 0x74, 0x49, 0x40, 0xef, 0xc0, 0x42, 0x34, 0xbc, 0xe4, 0x04,
-	0x4f, 0xad, 0x17, 0x8e, 0x46, 0x77, 0xa1, 0x12, 0x8a, 0x71, 0x79, 0xc1, 0x57, 0x1e, 0x2a, 0x21,
-	0x6e, 0x55, 0xfa, 0x55, 0x1a, 0x8f, 0x2b, 0x4f, 0xe7, 0xa4, 0x67, 0x5d, 0x95, 0x9e, 0xb5, 0x28,
-	0x46, 0x09, 0xdf, 0x1a, 0x72, 0x32, 0xdf, 0x0f, 0x3b, 0x19, 0xfc, 0x7d, 0xa8, 0x86, 0x0c, 0x44,
-	0xe3, 0x4e, 0xe7, 0xe3, 0xe7, 0xed, 0x7d, 0x1e, 0xa4, 0x9e, 0xb0, 0xb8, 0xa4, 0xd7, 0x35, 0x1a,
-	0xeb, 0xf6, 0x3b, 0xc7, 0xc7, 0xf5, 0x0c, 0xaa, 0x42, 0xe9, 0xe0, 0xb0, 0x7b, 0xc2, 0xb9, 0xb2,
-	0xf8, 0x89, 0x2f, 0x41, 0x04, 0x39, 0x25, 0xb6, 0xcd, 0x29, 0xb1, 0x4d, 0x93, 0xb1, 0x2d, 0x13,
-	0xc4, 0x36, 0x16, 0xe6, 0xf6, 0x3b, 0xed, 0xe3, 0x4e, 0x7d, 0xfe, 0x71, 0x0d, 0x2a, 0xdc, 0xbe,
-	0x27, 0x63, 0x8b, 0x86, 0xda, 0x7f, 0xd2, 0x00, 0x82, 0xd3, 0x84, 0x5a, 0x50, 0xe8, 0x71, 0x9c,
-	0x86, 0xc6, 0x9c, 0xd1, 0x4a, 0xe2, 0x92, 0xe9, 0x92, 0x0b, 0x7d, 0x0b, 0x0a, 0xee, 0xb8, 0xd7,
-	0x23, 0xae, 0x0c, 0x79, 0x37, 0xa2, 0xfe, 0x50, 0x78, 0x2b, 0x5d, 0xf2, 0xd1, 0x21, 0x2f, 0x0d,
-	0x73, 0x30, 0x66, 0x01, 0x70, 0xfa, 0x10, 0xc1, 0x87, 0xff, 0x5e, 0x83, 0xb2, 0xb2, 0x79, 0x7f,
-	0x47, 0x27, 0x7c, 0x0b, 0x4a, 0x4c, 0x07, 0xd2, 0x17, 0x6e, 0xb8, 0xa8, 0x07, 0x04, 0xf4, 0x7b,
-	0x50, 0x92, 0x27, 0x40, 0x7a, 0xe2, 0x46, 0xb2, 0xd8, 0xc3, 0x91, 0x1e, 0xb0, 0xe2, 0x3d, 0x58,
-	0x64, 0x56, 0xe9, 0xd1, 0xe4, 0x5a, 0xda, 0x51, 0x4d, 0x3f, 0xb5, 0x48, 0xfa, 0xd9, 0x84, 0xe2,
-	0xe8, 0xfc, 0xca, 0x35, 0x7b, 0xc6, 0x40, 0x68, 0xe1, 0xb7, 0xf1, 0x47, 0x80, 0x54, 0x61, 0xb3,
-	0x4c, 0x17, 0x57, 0xa1, 0xfc, 0xd4, 0x70, 0xcf, 0x85, 0x4a, 0xf8, 0x21, 0x54, 0x69, 0x73, 0xef,
-	0xc5, 0x6b, 0xe8, 0xc8, 0x2e, 0x07, 0x92, 0x7b, 0x26, 0x9b, 0x23, 0x98, 0x3f, 0x37, 0xdc, 0x73,
-	0x36, 0xd1, 0xaa, 0xce, 0x7e, 0xa3, 0x77, 0xa0, 0xde, 0xe3, 0x93, 0x3c, 0x89, 0x5c, 0x19, 0x16,
-	0x04, 0xdd, 0xcf, 0x04, 0x3f, 0x85, 0x0a, 0x9f, 0xc3, 0x57, 0xad, 0x04, 0x5e, 0x84, 0x85, 0x63,
-	0xcb, 0x18, 0xb9, 0xe7, 0xb6, 0x8c, 0x6e, 0x74, 0xd2, 0xf5, 0x80, 0x36, 0x13, 0xe2, 0xdb, 0xb0,
-	0xe0, 0x90, 0xa1, 0x61, 0x5a, 0xa6, 0x75, 0x76, 0x72, 0x7a, 0xe5, 0x11, 0x57, 0x5c, 0x98, 0x6a,
-	0x3e, 0xf9, 0x31, 0xa5, 0x52, 0xd5, 0x4e, 0x07, 0xf6, 0xa9, 0x70, 0x73, 0xec, 0x37, 0xfe, 0x69,
-	0x06, 0x2a, 0x9f, 0x18, 0x5e, 0x4f, 0x2e, 0x1d, 0xda, 0x85, 0x9a, 0xef, 0xdc, 0x18, 0x45, 0xe8,
-	0x12, 0x09, 0xb1, 0x6c, 0x8c, 0x4c, 0xa5, 0x65, 0x74, 0xac, 0xf6, 0x54, 0x02, 0x13, 0x65, 0x58,
-	0x3d, 0x32, 0xf0, 0x45, 0x65, 0xd2, 0x45, 0x31, 0x46, 0x55, 0x94, 0x4a, 0x40, 0x87, 0x50, 0x1f,
-	0x39, 0xf6, 0x99, 0x43, 0x5c, 0xd7, 0x17, 0xc6, 0xc3, 0x18, 0x4e, 0x10, 0x76, 0x24, 0x58, 0x03,
-	0x71, 0x0b, 0xa3, 0x30, 0xe9, 0xf1, 0x42, 0x90, 0xcf, 0x70, 0xe7, 0xf4, 0x9f, 0x19, 0x40, 0xf1,
-	0x49, 0xfd, 0xb6, 0x29, 0xde, 0x3d, 0xa8, 0xb9, 0x9e, 0xe1, 0xc4, 0x36, 0x5b, 0x95, 0x51, 0x7d,
-	0x8f, 0xff, 0x36, 0xf8, 0x0a, 0x9d, 0x58, 0xb6, 0x67, 0xbe, 0xbc, 0x12, 0x59, 0x72, 0x4d, 0x92,
-	0x0f, 0x18, 0x15, 0x75, 0xa0, 0xf0, 0xd2, 0x1c, 0x78, 0xc4, 0x71, 0x1b, 0xb9, 0xf5, 0xec, 0x46,
-	0x6d, 0xeb, 0xe1, 0x75, 0xcb, 0xb0, 0xf9, 0x21, 0xe3, 0xef, 0x5e, 0x8d, 0x88, 0x2e, 0xc7, 0xaa,
-	0x99, 0x67, 0x3e, 0x94, 0x8d, 0xdf, 0x84, 0xe2, 0x2b, 0x2a, 0x82, 0xde, 0xb2, 0x0b, 0x3c, 0x59,
-	0x64, 0x6d, 0x7e, 0xc9, 0x7e, 0xe9, 0x18, 0x67, 0x43, 0x62, 0x79, 0xf2, 0x1e, 0x28, 0xdb, 0xf8,
-	0x1e, 0x40, 0x00, 0x43, 0x5d, 0xfe, 0xc1, 0xe1, 0xd1, 0xf3, 0x6e, 0x7d, 0x0e, 0x55, 0xa0, 0x78,
-	0x70, 0xb8, 0xd3, 0xd9, 0xef, 0xd0, 0xf8, 0x80, 0x5b, 0xd2, 0xa4, 0xa1, 0xb5, 0x54, 0x31, 0xb5,
-	0x10, 0x26, 0x5e, 0x85, 0xe5, 0xa4, 0x05, 0xa4, 0xb9, 0x68, 0x55, 0xec, 0xd2, 0x99, 0x8e, 0x8a,
-	0x0a, 0x9d, 0x09, 0x4f, 0xb7, 0x01, 0x05, 0xbe, 0x7b, 0xfb, 0x22, 0x39, 0x97, 0x4d, 0x6a, 0x08,
-	0xbe, 0x19, 0x49, 0x5f, 0xac, 0x92, 0xdf, 0x4e, 0x74, 0x2f, 0xb9, 0x44, 0xf7, 0x82, 0xee, 0x42,
-	0xd5, 0x3f, 0x0d, 0x86, 0x2b, 0x72, 0x81, 0x92, 0x5e, 0x91, 0x1b, 0x9d, 0xd2, 0x42, 0x46, 0x2f,
-	0x84, 0x8d, 0x8e, 0xee, 0x41, 0x9e, 0x4c, 0x88, 0xe5, 0xb9, 0x8d, 0x32, 0x8b, 0x18, 0x55, 0x99,
-	0xbb, 0x77, 0x28, 0x55, 0x17, 0x9d, 0xf8, 0xbb, 0xb0, 0xc8, 0xee, 0x48, 0x4f, 0x1c, 0xc3, 0x52,
-	0x2f, 0x73, 0xdd, 0xee, 0xbe, 0x30, 0x37, 0xfd, 0x89, 0x6a, 0x90, 0xd9, 0xdd, 0x11, 0x46, 0xc8,
-	0xec, 0xee, 0xe0, 0x1f, 0x6b, 0x80, 0xd4, 0x71, 0x33, 0xd9, 0x39, 0x22, 0x5c, 0xc2, 0x67, 0x03,
-	0xf8, 0x65, 0xc8, 0x11, 0xc7, 0xb1, 0x1d, 0x66, 0xd1, 0x92, 0xce, 0x1b, 0xf8, 0x2d, 0xa1, 0x83,
-	0x4e, 0x26, 0xf6, 0x85, 0x7f, 0x06, 0xb9, 0x34, 0xcd, 0x57, 0x75, 0x0f, 0x96, 0x42, 0x5c, 0x33,
-	0x45, 0xae, 0x0f, 0x61, 0x81, 0x09, 0xdb, 0x3e, 0x27, 0xbd, 0x8b, 0x91, 0x6d, 0x5a, 0x31, 0x3c,
-	0xba, 0x72, 0x81, 0x83, 0xa5, 0xf3, 0xe0, 0x13, 0xab, 0xf8, 0xc4, 0x6e, 0x77, 0x1f, 0x7f, 0x06,
-	0xab, 0x11, 0x39, 0x52, 0xfd, 0x3f, 0x82, 0x72, 0xcf, 0x27, 0xba, 0x22, 0xd7, 0xb9, 0x1d, 0x56,
-	0x2e, 0x3a, 0x54, 0x1d, 0x81, 0x0f, 0xe1, 0x46, 0x4c, 0xf4, 0x4c, 0x73, 0x7e, 0x1b, 0x56, 0x98,
-	0xc0, 0x3d, 0x42, 0x46, 0xed, 0x81, 0x39, 0x49, 0xb5, 0xf4, 0x48, 0x4c, 0x4a, 0x61, 0xfc, 0x7a,
-	0xf7, 0x05, 0xfe, 0x03, 0x81, 0xd8, 0x35, 0x87, 0xa4, 0x6b, 0xef, 0xa7, 0xeb, 0x46, 0xa3, 0xd9,
-	0x05, 0xb9, 0x72, 0x45, 0x5a, 0xc3, 0x7e, 0xe3, 0x7f, 0xd6, 0x84, 0xa9, 0xd4, 0xe1, 0x5f, 0xf3,
-	0x4e, 0x5e, 0x03, 0x38, 0xa3, 0x47, 0x86, 0xf4, 0x69, 0x07, 0xaf, 0xa8, 0x28, 0x14, 0x5f, 0x4f,
-	0xea, 0xbf, 0x2b, 0x42, 0xcf, 0x65, 0xb1, 0xcf, 0xd9, 0x3f, 0xbe, 0x97, 0xbb, 0x0d, 0x65, 0x46,
-	0x38, 0xf6, 0x0c, 0x6f, 0xec, 0xc6, 0x16, 0xe3, 0x2f, 0xc4, 0xb6, 0x97, 0x83, 0x66, 0x9a, 0xd7,
-	0xb7, 0x20, 0xcf, 0x2e, 0x13, 0x32, 0x95, 0xbe, 0x99, 0xb0, 0x1f, 0xb9, 0x1e, 0xba, 0x60, 0xc4,
-	0x3f, 0xd5, 0x20, 0xff, 0x8c, 0x95, 0x60, 0x15, 0xd5, 0xe6, 0xe5, 0x5a, 0x58, 0xc6, 0x90, 0x17,
-	0x86, 0x4a, 0x3a, 0xfb, 0xcd, 0x52, 0x4f, 0x42, 0x9c, 0xe7, 0xfa, 0x3e, 0x4f, 0x71, 0x4b, 0xba,
-	0xdf, 0xa6, 0x36, 0xeb, 0x0d, 0x4c, 0x62, 0x79, 0xac, 0x77, 0x9e, 0xf5, 0x2a, 0x14, 0x9a, 0x3d,
-	0x9b, 0xee, 0x3e, 0x31, 0x1c, 0x4b, 0x14, 0x4d, 0x8b, 0x7a, 0x40, 0xc0, 0xfb, 0x50, 0xe7, 0x7a,
-	0xb4, 0xfb, 0x7d, 0x25, 0xc1, 0xf4, 0xd1, 0xb4, 0x08, 0x5a, 0x48, 0x5a, 0x26, 0x2a, 0xed, 0x17,
-	0x1a, 0x2c, 0x2a, 0xe2, 0x66, 0xb2, 0xea, 0xbb, 0x90, 0xe7, 0x45, 0x6a, 0x91, 0xe9, 0x2c, 0x87,
-	0x47, 0x71, 0x18, 0x5d, 0xf0, 0xa0, 0x4d, 0x28, 0xf0, 0x5f, 0xf2, 0x0e, 0x90, 0xcc, 0x2e, 0x99,
-	0xf0, 0x3d, 0x58, 0x12, 0x24, 0x32, 0xb4, 0x93, 0x0e, 0x06, 0x5b, 0x0c, 0xfc, 0x67, 0xb0, 0x1c,
-	0x66, 0x9b, 0x69, 0x4a, 0x8a, 0x92, 0x99, 0xd7, 0x51, 0xb2, 0x2d, 0x95, 0x7c, 0x3e, 0xea, 0x2b,
-	0x79, 0x54, 0x74, 0xc7, 0xa8, 0xeb, 0x95, 0x09, 0xaf, 0x57, 0x30, 0x01, 0x29, 0xe2, 0x1b, 0x9d,
-	0xc0, 0x07, 0x72, 0x3b, 0xec, 0x9b, 0xae, 0xef, 0xc3, 0x31, 0x54, 0x06, 0xa6, 0x45, 0x0c, 0x47,
-	0x54, 0xce, 0x35, 0x5e, 0x39, 0x57, 0x69, 0xf8, 0x0b, 0x40, 0xea, 0xc0, 0x6f, 0x54, 0xe9, 0xfb,
-	0xd2, 0x64, 0x47, 0x8e, 0x3d, 0xb4, 0x53, 0xcd, 0x8e, 0xff, 0x1c, 0x56, 0x22, 0x7c, 0xdf, 0xa8,
-	0x9a, 0x4b, 0xb0, 0xb8, 0x43, 0x64, 0x42, 0x23, 0xdd, 0xde, 0x47, 0x80, 0x54, 0xe2, 0x4c, 0x91,
-	0xad, 0x05, 0x8b, 0xcf, 0xec, 0x09, 0x75, 0x91, 0x94, 0x1a, 0xf8, 0x06, 0x5e, 0x87, 0xf0, 0x4d,
-	0xe1, 0xb7, 0x29, 0xb8, 0x3a, 0x60, 0x26, 0xf0, 0x7f, 0xd7, 0xa0, 0xd2, 0x1e, 0x18, 0xce, 0x50,
-	0x02, 0x7f, 0x0f, 0xf2, 0xfc, 0x76, 0x2d, 0x0a, 0x5a, 0xf7, 0xc3, 0x62, 0x54, 0x5e, 0xde, 0x68,
-	0xf3, 0xbb, 0xb8, 0x18, 0x45, 0x15, 0x17, 0x6f, 0x5e, 0x3b, 0x91, 0x37, 0xb0, 0x1d, 0xf4, 0x1e,
-	0xe4, 0x0c, 0x3a, 0x84, 0x85, 0xa2, 0x5a, 0xb4, 0xae, 0xc1, 0xa4, 0xb1, 0x3b, 0x00, 0xe7, 0xc2,
-	0xdf, 0x81, 0xb2, 0x82, 0x80, 0x0a, 0x90, 0x7d, 0xd2, 0x11, 0x09, 0x7b, 0x7b, 0xbb, 0xbb, 0xfb,
-	0x82, 0x17, 0x74, 0x6a, 0x00, 0x3b, 0x1d, 0xbf, 0x9d, 0xc1, 0x9f, 0x8a, 0x51, 0xc2, 0xed, 0xab,
-	0xfa, 0x68, 0x69, 0xfa, 0x64, 0x5e, 0x4b, 0x9f, 0x4b, 0xa8, 0x8a, 0xe9, 0xcf, 0x1a, 0xc6, 0x98,
-	0xbc, 0x94, 0x30, 0xa6, 0x28, 0xaf, 0x0b, 0x46, 0xfc, 0x2b, 0x0d, 0xea, 0x3b, 0xf6, 0x2b, 0xeb,
-	0xcc, 0x31, 0xfa, 0xfe, 0x39, 0xf9, 0x30, 0xb2, 0x52, 0x9b, 0x91, 0xe2, 0x68, 0x84, 0x3f, 0x20,
-	0x44, 0x56, 0xac, 0x11, 0x94, 0x0d, 0x79, 0x2c, 0x94, 0x4d, 0xfc, 0x01, 0x2c, 0x44, 0x06, 0x51,
-	0xdb, 0xbf, 0x68, 0xef, 0xef, 0xee, 0x50, 0x5b, 0xb3, 0xc2, 0x5a, 0xe7, 0xa0, 0xfd, 0x78, 0xbf,
-	0x23, 0x1e, 0x90, 0xda, 0x07, 0xdb, 0x9d, 0xfd, 0x7a, 0x06, 0xf7, 0x60, 0x51, 0x81, 0x9f, 0xf5,
-	0x65, 0x20, 0x45, 0xbb, 0x05, 0xa8, 0x8a, 0x68, 0x2f, 0x0e, 0xe5, 0xbf, 0x65, 0xa0, 0x26, 0x29,
-	0x5f, 0x0f, 0x26, 0x5a, 0x85, 0x7c, 0xff, 0xf4, 0xd8, 0xfc, 0x42, 0xbe, 0x1c, 0x89, 0x16, 0xa5,
-	0x0f, 0x38, 0x0e, 0x7f, 0xbe, 0x15, 0x2d, 0x1a, 0xc6, 0x1d, 0xe3, 0xa5, 0xb7, 0x6b, 0xf5, 0xc9,
-	0x25, 0x4b, 0x0a, 0xe6, 0xf5, 0x80, 0xc0, 0x2a, 0x4c, 0xe2, 0x99, 0x97, 0xdd, 0xac, 0x94, 0x67,
-	0x5f, 0xf4, 0x00, 0xea, 0xf4, 0x77, 0x7b, 0x34, 0x1a, 0x98, 0xa4, 0xcf, 0x05, 0x14, 0x18, 0x4f,
-	0x8c, 0x4e, 0xd1, 0xd9, 0x5d, 0xc4, 0x6d, 0x14, 0x59, 0x58, 0x12, 0x2d, 0xb4, 0x0e, 0x65, 0xae,
-	0xdf, 0xae, 0xf5, 0xdc, 0x25, 0xec, 0xed, 0x33, 0xab, 0xab, 0xa4, 0x70, 0x9a, 0x01, 0xd1, 0x34,
-	0x63, 0x09, 0x16, 0xdb, 0x63, 0xef, 0xbc, 0x63, 0xd1, 0x58, 0x21, 0xad, 0xbc, 0x0c, 0x88, 0x12,
-	0x77, 0x4c, 0x57, 0xa5, 0x0a, 0xd6, 0xf0, 0x82, 0x74, 0x60, 0x89, 0x12, 0x89, 0xe5, 0x99, 0x3d,
-	0x25, 0xae, 0xca, 0xcc, 0x4b, 0x8b, 0x64, 0x5e, 0x86, 0xeb, 0xbe, 0xb2, 0x9d, 0xbe, 0xb0, 0xb9,
-	0xdf, 0xc6, 0xff, 0xa8, 0x71, 0xc8, 0xe7, 0x6e, 0x28, 0x7d, 0xfa, 0x2d, 0xc5, 0xa0, 0xf7, 0xa1,
-	0x60, 0x8f, 0xd8, 0x0b, 0xbf, 0x28, 0xc3, 0xac, 0x6e, 0xf2, 0x6f, 0x02, 0x36, 0x85, 0xe0, 0x43,
-	0xde, 0xab, 0x4b, 0x36, 0x74, 0x1f, 0x6a, 0xe7, 0x86, 0x7b, 0x4e, 0xfa, 0x47, 0x52, 0x26, 0xbf,
-	0xf9, 0x45, 0xa8, 0x78, 0x23, 0xd0, 0xef, 0x09, 0xf1, 0xa6, 0xe8, 0x87, 0x1f, 0xc2, 0x8a, 0xe4,
-	0x14, 0xaf, 0x13, 0x53, 0x98, 0x5f, 0xc1, 0x6d, 0xc9, 0xbc, 0x7d, 0x6e, 0x58, 0x67, 0x44, 0x02,
-	0xfe, 0xae, 0x16, 0x88, 0xcf, 0x27, 0x9b, 0x38, 0x9f, 0xc7, 0xd0, 0xf0, 0xe7, 0xc3, 0x6e, 0xd6,
-	0xf6, 0x40, 0x55, 0x74, 0xec, 0x8a, 0xf3, 0x54, 0xd2, 0xd9, 0x6f, 0x4a, 0x73, 0xec, 0x81, 0x9f,
-	0x4a, 0xd3, 0xdf, 0x78, 0x1b, 0x6e, 0x4a, 0x19, 0xe2, 0xce, 0x1b, 0x16, 0x12, 0x53, 0x3c, 0x49,
-	0x88, 0x30, 0x2c, 0x1d, 0x3a, 0x7d, 0xe1, 0x55, 0xce, 0xf0, 0x12, 0x30, 0x99, 0x9a, 0x22, 0x73,
-	0x85, 0x6f, 0x4a, 0xaa, 0x98, 0x92, 0x2d, 0x49, 0x32, 0x15, 0xa0, 0x92, 0xc5, 0x82, 0x51, 0x72,
-	0x6c, 0xc1, 0x62, 0xa2, 0x7f, 0x00, 0x6b, 0xbe, 0x12, 0xd4, 0x6e, 0x47, 0xc4, 0x19, 0x9a, 0xae,
-	0xab, 0xd4, 0xbd, 0x93, 0x26, 0x7e, 0x1f, 0xe6, 0x47, 0x44, 0x04, 0xa1, 0xf2, 0x16, 0x92, 0x9b,
-	0x52, 0x19, 0xcc, 0xfa, 0x71, 0x1f, 0xee, 0x48, 0xe9, 0xdc, 0xa2, 0x89, 0xe2, 0xa3, 0x4a, 0xc9,
-	0x6a, 0x60, 0x26, 0xa5, 0x1a, 0x98, 0x8d, 0xbc, 0xc5, 0x7c, 0xc4, 0x0d, 0x29, 0xcf, 0xfc, 0x4c,
-	0xc9, 0xc5, 0x1e, 0xb7, 0xa9, 0xef, 0x2a, 0x66, 0x12, 0xf6, 0xd7, 0xc2, 0x0b, 0x7c, 0x55, 0x1e,
-	0x9e, 0xb0, 0x19, 0xca, 0x87, 0x0e, 0xd9, 0xa4, 0x59, 0x33, 0x5d, 0x00, 0x5d, 0xad, 0x85, 0xce,
-	0xeb, 0x21, 0x1a, 0x3e, 0x85, 0xe5, 0xb0, 0x5f, 0x9b, 0x49, 0x97, 0x65, 0xc8, 0x79, 0xf6, 0x05,
-	0x91, 0xb1, 0x86, 0x37, 0xa4, 0xed, 0x7c, 0x9f, 0x37, 0x93, 0xed, 0x8c, 0x40, 0x18, 0x3b, 0x1d,
-	0xb3, 0xea, 0x4b, 0x37, 0x96, 0xbc, 0x03, 0xf1, 0x06, 0x3e, 0x80, 0xd5, 0xa8, 0x67, 0x9b, 0x49,
-	0xe5, 0x17, 0xfc, 0x2c, 0x25, 0x39, 0xbf, 0x99, 0xe4, 0x7e, 0x1c, 0xf8, 0x25, 0xc5, 0xb7, 0xcd,
-	0x24, 0x52, 0x87, 0x66, 0x92, 0xab, 0xfb, 0x2a, 0x8e, 0x8e, 0xef, 0xf9, 0x66, 0x12, 0xe6, 0x06,
-	0xc2, 0x66, 0x5f, 0xfe, 0xc0, 0x5d, 0x65, 0xa7, 0xba, 0x2b, 0x71, 0x48, 0x02, 0x87, 0xfa, 0x35,
-	0x6c, 0x3a, 0x81, 0x11, 0xf8, 0xf2, 0x59, 0x31, 0x68, 0x38, 0xf3, 0x31, 0x58, 0x43, 0x6e, 0x6c,
-	0x35, 0x02, 0xcc, 0xb4, 0x18, 0x9f, 0x04, 0x6e, 0x3c, 0x16, 0x24, 0x66, 0x12, 0xfc, 0x29, 0xac,
-	0xa7, 0xc7, 0x87, 0x59, 0x24, 0x3f, 0x68, 0x41, 0xc9, 0xbf, 0x0c, 0x29, 0xdf, 0x9b, 0x95, 0xa1,
-	0x70, 0x70, 0x78, 0x7c, 0xd4, 0xde, 0xee, 0xf0, 0x0f, 0xce, 0xb6, 0x0f, 0x75, 0xfd, 0xf9, 0x51,
-	0xb7, 0x9e, 0xd9, 0xfa, 0x75, 0x16, 0x32, 0x7b, 0x2f, 0xd0, 0x67, 0x90, 0xe3, 0x5f, 0x5f, 0x4c,
-	0xf9, 0xe4, 0xa6, 0x39, 0xed, 0x03, 0x13, 0x7c, 0xe3, 0xc7, 0xff, 0xf5, 0xeb, 0x9f, 0x67, 0x16,
-	0x71, 0xa5, 0x35, 0xf9, 0x76, 0xeb, 0x62, 0xd2, 0x62, 0x61, 0xea, 0x91, 0xf6, 0x00, 0x7d, 0x0c,
-	0xd9, 0xa3, 0xb1, 0x87, 0x52, 0x3f, 0xc5, 0x69, 0xa6, 0x7f, 0x73, 0x82, 0x57, 0x98, 0xd0, 0x05,
-	0x0c, 0x42, 0xe8, 0x68, 0xec, 0x51, 0x91, 0x3f, 0x84, 0xb2, 0xfa, 0xc5, 0xc8, 0xb5, 0xdf, 0xe7,
-	0x34, 0xaf, 0xff, 0x1a, 0x05, 0xdf, 0x66, 0x50, 0x37, 0x30, 0x12, 0x50, 0xfc, 0x9b, 0x16, 0x75,
-	0x16, 0xdd, 0x4b, 0x0b, 0xa5, 0x7e, 0xbd, 0xd3, 0x4c, 0xff, 0x40, 0x25, 0x36, 0x0b, 0xef, 0xd2,
-	0xa2, 0x22, 0xff, 0x44, 0x7c, 0x9b, 0xd2, 0xf3, 0xd0, 0x9d, 0x84, 0x6f, 0x13, 0xd4, 0x57, 0xf8,
-	0xe6, 0x7a, 0x3a, 0x83, 0x00, 0xb9, 0xc5, 0x40, 0x56, 0xf1, 0xa2, 0x00, 0xe9, 0xf9, 0x2c, 0x8f,
-	0xb4, 0x07, 0x5b, 0x3d, 0xc8, 0xb1, 0x17, 0x2e, 0xf4, 0xb9, 0xfc, 0xd1, 0x4c, 0x78, 0xea, 0x4b,
-	0x59, 0xe8, 0xd0, 0xdb, 0x18, 0x5e, 0x66, 0x40, 0x35, 0x5c, 0xa2, 0x40, 0xec, 0x7d, 0xeb, 0x91,
-	0xf6, 0x60, 0x43, 0x7b, 0x5f, 0xdb, 0xfa, 0x55, 0x0e, 0x72, 0xac, 0xb4, 0x8b, 0x2e, 0x00, 0x82,
-	0xd7, 0x9e, 0xe8, 0xec, 0x62, 0xef, 0x47, 0xd1, 0xd9, 0xc5, 0x1f, 0x8a, 0x70, 0x93, 0x81, 0x2e,
-	0xe3, 0x05, 0x0a, 0xca, 0x2a, 0xc6, 0x2d, 0x56, 0x04, 0xa7, 0x76, 0xfc, 0x1b, 0x4d, 0x54, 0xb6,
-	0xf9, 0x59, 0x42, 0x49, 0xd2, 0x42, 0x4f, 0x3e, 0xd1, 0xed, 0x90, 0xf0, 0xdc, 0x83, 0xbf, 0xcb,
-	0x00, 0x5b, 0xb8, 0x1e, 0x00, 0x3a, 0x8c, 0xe3, 0x91, 0xf6, 0xe0, 0xf3, 0x06, 0x5e, 0x12, 0x56,
-	0x8e, 0xf4, 0xa0, 0x1f, 0x41, 0x2d, 0xfc, 0xa4, 0x81, 0xee, 0x26, 0x60, 0x45, 0x5f, 0x46, 0x9a,
-	0x6f, 0x4d, 0x67, 0x12, 0x3a, 0xad, 0x31, 0x9d, 0x04, 0x38, 0x47, 0xbe, 0x20, 0x64, 0x64, 0x50,
-	0x26, 0xb1, 0x06, 0xe8, 0x1f, 0x34, 0xf1, 0xe2, 0x14, 0xbc, 0x51, 0xa0, 0x24, 0xe9, 0xb1, 0x17,
-	0x90, 0xe6, 0xbd, 0x6b, 0xb8, 0x84, 0x12, 0x7f, 0xc8, 0x94, 0xf8, 0x00, 0x2f, 0x07, 0x4a, 0x78,
-	0xe6, 0x90, 0x78, 0xb6, 0xd0, 0xe2, 0xf3, 0x5b, 0xf8, 0x46, 0xc8, 0x38, 0xa1, 0xde, 0x60, 0xb1,
-	0xf8, 0x3b, 0x43, 0xe2, 0x62, 0x85, 0xde, 0x2d, 0x12, 0x17, 0x2b, 0xfc, 0x48, 0x91, 0xb4, 0x58,
-	0xfc, 0x55, 0x21, 0x69, 0xb1, 0xfc, 0x9e, 0xad, 0xff, 0x9b, 0x87, 0xc2, 0x36, 0xff, 0x26, 0x1c,
-	0xd9, 0x50, 0xf2, 0xcb, 0xf4, 0x68, 0x2d, 0xa9, 0xce, 0x18, 0x5c, 0x6b, 0x9a, 0x77, 0x52, 0xfb,
-	0x85, 0x42, 0x6f, 0x32, 0x85, 0xde, 0xc0, 0xab, 0x14, 0x59, 0x7c, 0x76, 0xde, 0xe2, 0xc5, 0xac,
-	0x96, 0xd1, 0xef, 0x53, 0x43, 0xfc, 0x29, 0x54, 0xd4, 0x3a, 0x3a, 0x7a, 0x33, 0xb1, 0xb6, 0xa9,
-	0x96, 0xe2, 0x9b, 0x78, 0x1a, 0x8b, 0x40, 0x7e, 0x8b, 0x21, 0xaf, 0xe1, 0x9b, 0x09, 0xc8, 0x0e,
-	0x63, 0x0d, 0x81, 0xf3, 0x1a, 0x78, 0x32, 0x78, 0xa8, 0xc4, 0x9e, 0x0c, 0x1e, 0x2e, 0xa1, 0x4f,
-	0x05, 0x1f, 0x33, 0x56, 0x0a, 0xee, 0x02, 0x04, 0x95, 0x6c, 0x94, 0x68, 0x4b, 0xe5, 0x5e, 0x17,
-	0x75, 0x0e, 0xf1, 0x22, 0x38, 0xc6, 0x0c, 0x56, 0xec, 0xbb, 0x08, 0xec, 0xc0, 0x74, 0x3d, 0x7e,
-	0x30, 0xab, 0xa1, 0xd2, 0x34, 0x4a, 0x9c, 0x4f, 0xb8, 0xbe, 0xdd, 0xbc, 0x3b, 0x95, 0x47, 0xa0,
-	0xdf, 0x63, 0xe8, 0x77, 0x70, 0x33, 0x01, 0x7d, 0xc4, 0x79, 0xe9, 0x66, 0xfb, 0xff, 0x3c, 0x94,
-	0x9f, 0x19, 0xa6, 0xe5, 0x11, 0xcb, 0xb0, 0x7a, 0x04, 0x9d, 0x42, 0x8e, 0x45, 0xea, 0xa8, 0x23,
-	0x56, 0xcb, 0xb6, 0x51, 0x47, 0x1c, 0xaa, 0x69, 0xe2, 0x75, 0x06, 0xdc, 0xc4, 0x2b, 0x14, 0x78,
-	0x18, 0x88, 0x6e, 0xb1, 0x52, 0x24, 0x9d, 0xf4, 0x4b, 0xc8, 0x8b, 0xd7, 0xbe, 0x88, 0xa0, 0x50,
-	0xf1, 0xa7, 0x79, 0x2b, 0xb9, 0x33, 0x69, 0x2f, 0xab, 0x30, 0x2e, 0xe3, 0xa3, 0x38, 0x13, 0x80,
-	0xa0, 0xc6, 0x1e, 0x5d, 0xd1, 0x58, 0x49, 0xbe, 0xb9, 0x9e, 0xce, 0x90, 0x64, 0x53, 0x15, 0xb3,
-	0xef, 0xf3, 0x52, 0xdc, 0x3f, 0x86, 0xf9, 0xa7, 0x86, 0x7b, 0x8e, 0x22, 0xb1, 0x57, 0xf9, 0x56,
-	0xac, 0xd9, 0x4c, 0xea, 0x12, 0x28, 0x77, 0x18, 0xca, 0x4d, 0xee, 0xca, 0x54, 0x94, 0x73, 0xc3,
-	0xa5, 0x41, 0x0d, 0xf5, 0x21, 0xcf, 0x3f, 0x1d, 0x8b, 0xda, 0x2f, 0xf4, 0xf9, 0x59, 0xd4, 0x7e,
-	0xe1, 0xaf, 0xcd, 0xae, 0x47, 0x19, 0x41, 0x51, 0x7e, 0xab, 0x85, 0x22, 0x0f, 0xf7, 0x91, 0xef,
-	0xba, 0x9a, 0x6b, 0x69, 0xdd, 0x02, 0xeb, 0x2e, 0xc3, 0xba, 0x8d, 0x1b, 0xb1, 0xb5, 0x12, 0x9c,
-	0x8f, 0xb4, 0x07, 0xef, 0x6b, 0xe8, 0x47, 0x00, 0xc1, 0xb3, 0x44, 0xec, 0x04, 0x46, 0x5f, 0x38,
-	0x62, 0x27, 0x30, 0xf6, 0xa2, 0x81, 0x37, 0x19, 0xee, 0x06, 0xbe, 0x1b, 0xc5, 0xf5, 0x1c, 0xc3,
-	0x72, 0x5f, 0x12, 0xe7, 0x3d, 0x5e, 0x65, 0x75, 0xcf, 0xcd, 0x11, 0x9d, 0xb2, 0x03, 0x25, 0xbf,
-	0xea, 0x1c, 0xf5, 0xb6, 0xd1, 0x6a, 0x78, 0xd4, 0xdb, 0xc6, 0xca, 0xd5, 0x61, 0xb7, 0x13, 0xda,
-	0x2d, 0x92, 0x95, 0x1e, 0xc0, 0x5f, 0xd4, 0x61, 0x9e, 0x66, 0xdd, 0x34, 0x39, 0x09, 0xea, 0x26,
-	0xd1, 0xd9, 0xc7, 0xaa, 0xa8, 0xd1, 0xd9, 0xc7, 0x4b, 0x2e, 0xe1, 0xe4, 0x84, 0x5e, 0xb2, 0x5a,
-	0xbc, 0x44, 0x41, 0x67, 0x6a, 0x43, 0x59, 0x29, 0xac, 0xa0, 0x04, 0x61, 0xe1, 0xf2, 0x6c, 0x34,
-	0xdc, 0x25, 0x54, 0x65, 0xf0, 0x1b, 0x0c, 0x6f, 0x85, 0x87, 0x3b, 0x86, 0xd7, 0xe7, 0x1c, 0x14,
-	0x50, 0xcc, 0x4e, 0x9c, 0xfb, 0x84, 0xd9, 0x85, 0xcf, 0xfe, 0x7a, 0x3a, 0x43, 0xea, 0xec, 0x82,
-	0x83, 0xff, 0x0a, 0x2a, 0x6a, 0x79, 0x05, 0x25, 0x28, 0x1f, 0x29, 0x29, 0x47, 0xe3, 0x48, 0x52,
-	0x75, 0x26, 0xec, 0xd9, 0x18, 0xa4, 0xa1, 0xb0, 0x51, 0xe0, 0x01, 0x14, 0x44, 0xbd, 0x25, 0xc9,
-	0xa4, 0xe1, 0xf2, 0x73, 0x92, 0x49, 0x23, 0xc5, 0x9a, 0x70, 0xf6, 0xcc, 0x10, 0xe9, 0x95, 0x52,
-	0xc6, 0x6a, 0x81, 0xf6, 0x84, 0x78, 0x69, 0x68, 0x41, 0x25, 0x33, 0x0d, 0x4d, 0xb9, 0xce, 0xa7,
-	0xa1, 0x9d, 0x11, 0x4f, 0xf8, 0x03, 0x79, 0x4d, 0x46, 0x29, 0xc2, 0xd4, 0xf8, 0x88, 0xa7, 0xb1,
-	0x24, 0x5d, 0x6e, 0x02, 0x40, 0x19, 0x1c, 0x2f, 0x01, 0x82, 0x6a, 0x50, 0x34, 0x63, 0x4d, 0xac,
-	0x82, 0x47, 0x33, 0xd6, 0xe4, 0x82, 0x52, 0xd8, 0xf7, 0x05, 0xb8, 0xfc, 0x6e, 0x45, 0x91, 0x7f,
-	0xa6, 0x01, 0x8a, 0x17, 0x8e, 0xd0, 0xc3, 0x64, 0xe9, 0x89, 0xb5, 0xf5, 0xe6, 0xbb, 0xaf, 0xc7,
-	0x9c, 0x14, 0xce, 0x02, 0x95, 0x7a, 0x8c, 0x7b, 0xf4, 0x8a, 0x2a, 0xf5, 0x97, 0x1a, 0x54, 0x43,
-	0x55, 0x27, 0x74, 0x3f, 0x65, 0x4d, 0x23, 0x25, 0xf7, 0xe6, 0xdb, 0xd7, 0xf2, 0x25, 0xa5, 0xf2,
-	0xca, 0x0e, 0x90, 0x77, 0x9a, 0x9f, 0x68, 0x50, 0x0b, 0x57, 0xa9, 0x50, 0x8a, 0xec, 0x58, 0xc9,
-	0xbe, 0xb9, 0x71, 0x3d, 0xe3, 0xf4, 0xe5, 0x09, 0xae, 0x33, 0x03, 0x28, 0x88, 0xba, 0x56, 0xd2,
-	0xc6, 0x0f, 0x17, 0xfb, 0x93, 0x36, 0x7e, 0xa4, 0x28, 0x96, 0xb0, 0xf1, 0x1d, 0x7b, 0x40, 0x94,
-	0x63, 0x26, 0x0a, 0x5f, 0x69, 0x68, 0xd3, 0x8f, 0x59, 0xa4, 0x6a, 0x96, 0x86, 0x16, 0x1c, 0x33,
-	0x59, 0xf1, 0x42, 0x29, 0xc2, 0xae, 0x39, 0x66, 0xd1, 0x82, 0x59, 0xc2, 0x31, 0x63, 0x80, 0xca,
-	0x31, 0x0b, 0x6a, 0x53, 0x49, 0xc7, 0x2c, 0xf6, 0x76, 0x91, 0x74, 0xcc, 0xe2, 0xe5, 0xad, 0x84,
-	0x75, 0x64, 0xb8, 0xa1, 0x63, 0xb6, 0x94, 0x50, 0xc6, 0x42, 0xef, 0xa6, 0x18, 0x31, 0xf1, 0x49,
-	0xa4, 0xf9, 0xde, 0x6b, 0x72, 0xa7, 0xee, 0x71, 0x6e, 0x7e, 0xb9, 0xc7, 0xff, 0x56, 0x83, 0xe5,
-	0xa4, 0x12, 0x18, 0x4a, 0xc1, 0x49, 0x79, 0x4a, 0x69, 0x6e, 0xbe, 0x2e, 0xfb, 0x74, 0x6b, 0xf9,
-	0xbb, 0xfe, 0x71, 0xfd, 0x5f, 0xbf, 0x5c, 0xd3, 0xfe, 0xe3, 0xcb, 0x35, 0xed, 0xbf, 0xbf, 0x5c,
-	0xd3, 0xfe, 0xee, 0x7f, 0xd6, 0xe6, 0x4e, 0xf3, 0xec, 0x3f, 0x1a, 0x7f, 0xfb, 0x37, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xee, 0x4f, 0x63, 0x90, 0xed, 0x3c, 0x00, 0x00,
+	0x75, 0xe6, 0x00, 0x24, 0x2e, 0x07, 0x17, 0x82, 0x4d, 0x8a, 0x82, 0x66, 0x25, 0x0a, 0x6c, 0x5d,
+	0x96, 0x2b, 0xed, 0x92, 0x6b, 0xda, 0xce, 0x56, 0x29, 0x89, 0x63, 0x88, 0x84, 0x24, 0x2e, 0x21,
+	0x92, 0x3b, 0x84, 0xa4, 0xdd, 0x2d, 0x57, 0x58, 0x43, 0xa0, 0x45, 0x4e, 0x08, 0xcc, 0xc0, 0x33,
+	0x03, 0x8a, 0xdc, 0x5c, 0x9c, 0x72, 0x39, 0xae, 0xe4, 0xd5, 0xae, 0x4a, 0x25, 0x0f, 0xc9, 0x4b,
+	0x2a, 0xe5, 0xf2, 0x83, 0x9f, 0xf3, 0x17, 0xf2, 0x94, 0x4b, 0xe5, 0x0f, 0xa4, 0x36, 0x7e, 0x49,
+	0x7e, 0x44, 0xca, 0xd5, 0xb7, 0x99, 0x9e, 0xc1, 0x0c, 0x44, 0x1b, 0xbb, 0xfb, 0x02, 0x4e, 0x9f,
+	0x3e, 0x7d, 0xbe, 0xd3, 0xa7, 0xbb, 0xcf, 0xe9, 0x3e, 0xdd, 0x84, 0xa2, 0x3b, 0xec, 0xae, 0x0f,
+	0x5d, 0xc7, 0x77, 0x50, 0x99, 0xf8, 0xdd, 0x9e, 0x47, 0xdc, 0x73, 0xe2, 0x0e, 0x8f, 0xf5, 0xa5,
+	0x13, 0xe7, 0xc4, 0x61, 0x15, 0x1b, 0xf4, 0x8b, 0xf3, 0xe8, 0x75, 0xca, 0xb3, 0x61, 0x0e, 0xad,
+	0x8d, 0xc1, 0x79, 0xb7, 0x3b, 0x3c, 0xde, 0x38, 0x3b, 0x17, 0x35, 0x7a, 0x50, 0x63, 0x8e, 0xfc,
+	0xd3, 0xe1, 0x31, 0xfb, 0x23, 0xea, 0x6e, 0x9e, 0x38, 0xce, 0x49, 0x9f, 0xf0, 0x5a, 0xdb, 0x76,
+	0x7c, 0xd3, 0xb7, 0x1c, 0xdb, 0xe3, 0xb5, 0xf8, 0xaf, 0x34, 0xa8, 0x1a, 0xc4, 0x1b, 0x3a, 0xb6,
+	0x47, 0x9e, 0x11, 0xb3, 0x47, 0x5c, 0x74, 0x0b, 0xa0, 0xdb, 0x1f, 0x79, 0x3e, 0x71, 0x8f, 0xac,
+	0x5e, 0x5d, 0x6b, 0x68, 0x6b, 0xb3, 0x46, 0x51, 0x50, 0x76, 0x7a, 0xe8, 0x1d, 0x28, 0x0e, 0xc8,
+	0xe0, 0x98, 0xd7, 0x66, 0x58, 0x6d, 0x81, 0x13, 0x76, 0x7a, 0x48, 0x87, 0x82, 0x4b, 0xce, 0x2d,
+	0xcf, 0x72, 0xec, 0x7a, 0xb6, 0xa1, 0xad, 0x65, 0x8d, 0xa0, 0x4c, 0x1b, 0xba, 0xe6, 0x6b, 0xff,
+	0xc8, 0x27, 0xee, 0xa0, 0x3e, 0xcb, 0x1b, 0x52, 0x42, 0x87, 0xb8, 0x03, 0xfc, 0x93, 0x39, 0x28,
+	0x1b, 0xa6, 0x7d, 0x42, 0x0c, 0xf2, 0xc3, 0x11, 0xf1, 0x7c, 0x54, 0x83, 0xec, 0x19, 0xb9, 0x64,
+	0xf0, 0x65, 0x83, 0x7e, 0xf2, 0xf6, 0xf6, 0x09, 0x39, 0x22, 0x36, 0x07, 0x2e, 0xd3, 0xf6, 0xf6,
+	0x09, 0x69, 0xd9, 0x3d, 0xb4, 0x04, 0x73, 0x7d, 0x6b, 0x60, 0xf9, 0x02, 0x95, 0x17, 0x22, 0xea,
+	0xcc, 0xc6, 0xd4, 0xd9, 0x02, 0xf0, 0x1c, 0xd7, 0x3f, 0x72, 0xdc, 0x1e, 0x71, 0xeb, 0x73, 0x0d,
+	0x6d, 0xad, 0xba, 0x79, 0x77, 0x5d, 0x1d, 0x86, 0x75, 0x55, 0xa1, 0xf5, 0x43, 0xc7, 0xf5, 0xf7,
+	0x29, 0xaf, 0x51, 0xf4, 0xe4, 0x27, 0x7a, 0x02, 0x25, 0x26, 0xc4, 0x37, 0xdd, 0x13, 0xe2, 0xd7,
+	0x73, 0x4c, 0xca, 0xbd, 0xb7, 0x48, 0xe9, 0x30, 0x66, 0x03, 0xbc, 0xe0, 0x1b, 0x61, 0x28, 0x7b,
+	0xc4, 0xb5, 0xcc, 0xbe, 0xf5, 0x85, 0x79, 0xdc, 0x27, 0xf5, 0x7c, 0x43, 0x5b, 0x2b, 0x18, 0x11,
+	0x1a, 0xed, 0xff, 0x19, 0xb9, 0xf4, 0x8e, 0x1c, 0xbb, 0x7f, 0x59, 0x2f, 0x30, 0x86, 0x02, 0x25,
+	0xec, 0xdb, 0xfd, 0x4b, 0x36, 0x68, 0xce, 0xc8, 0xf6, 0x79, 0x6d, 0x91, 0xd5, 0x16, 0x19, 0x85,
+	0x55, 0xaf, 0x41, 0x6d, 0x60, 0xd9, 0x47, 0x03, 0xa7, 0x77, 0x14, 0x18, 0x04, 0x98, 0x41, 0xaa,
+	0x03, 0xcb, 0x7e, 0xee, 0xf4, 0x0c, 0x69, 0x16, 0xca, 0x69, 0x5e, 0x44, 0x39, 0x4b, 0x82, 0xd3,
+	0xbc, 0x50, 0x39, 0xd7, 0x61, 0x91, 0xca, 0xec, 0xba, 0xc4, 0xf4, 0x49, 0xc8, 0x5c, 0x66, 0xcc,
+	0x0b, 0x03, 0xcb, 0xde, 0x62, 0x35, 0x11, 0x7e, 0xf3, 0x62, 0x8c, 0xbf, 0x22, 0xf8, 0xcd, 0x8b,
+	0x28, 0x3f, 0x5e, 0x87, 0x62, 0x60, 0x73, 0x54, 0x80, 0xd9, 0xbd, 0xfd, 0xbd, 0x56, 0x6d, 0x06,
+	0x01, 0xe4, 0x9a, 0x87, 0x5b, 0xad, 0xbd, 0xed, 0x9a, 0x86, 0x4a, 0x90, 0xdf, 0x6e, 0xf1, 0x42,
+	0x06, 0x3f, 0x06, 0x08, 0xad, 0x8b, 0xf2, 0x90, 0xdd, 0x6d, 0x7d, 0x56, 0x9b, 0xa1, 0x3c, 0x2f,
+	0x5b, 0xc6, 0xe1, 0xce, 0xfe, 0x5e, 0x4d, 0xa3, 0x8d, 0xb7, 0x8c, 0x56, 0xb3, 0xd3, 0xaa, 0x65,
+	0x28, 0xc7, 0xf3, 0xfd, 0xed, 0x5a, 0x16, 0x15, 0x61, 0xee, 0x65, 0xb3, 0xfd, 0xa2, 0x55, 0x9b,
+	0xc5, 0x3f, 0xd7, 0xa0, 0x22, 0xc6, 0x8b, 0xaf, 0x09, 0xf4, 0x1d, 0xc8, 0x9d, 0xb2, 0x75, 0xc1,
+	0xa6, 0x62, 0x69, 0xf3, 0x66, 0x6c, 0x70, 0x23, 0x6b, 0xc7, 0x10, 0xbc, 0x08, 0x43, 0xf6, 0xec,
+	0xdc, 0xab, 0x67, 0x1a, 0xd9, 0xb5, 0xd2, 0x66, 0x6d, 0x9d, 0xaf, 0xd7, 0xf5, 0x5d, 0x72, 0xf9,
+	0xd2, 0xec, 0x8f, 0x88, 0x41, 0x2b, 0x11, 0x82, 0xd9, 0x81, 0xe3, 0x12, 0x36, 0x63, 0x0b, 0x06,
+	0xfb, 0xa6, 0xd3, 0x98, 0x0d, 0x9a, 0x98, 0xad, 0xbc, 0x80, 0x7f, 0xa9, 0x01, 0x1c, 0x8c, 0xfc,
+	0xf4, 0xa5, 0xb1, 0x04, 0x73, 0xe7, 0x54, 0xb0, 0x58, 0x16, 0xbc, 0xc0, 0xd6, 0x04, 0x31, 0x3d,
+	0x12, 0xac, 0x09, 0x5a, 0x40, 0xd7, 0x21, 0x3f, 0x74, 0xc9, 0xf9, 0xd1, 0xd9, 0x39, 0x03, 0x29,
+	0x18, 0x39, 0x5a, 0xdc, 0x3d, 0x47, 0xab, 0x50, 0xb6, 0x4e, 0x6c, 0xc7, 0x25, 0x47, 0x5c, 0xd6,
+	0x1c, 0xab, 0x2d, 0x71, 0x1a, 0xd3, 0x5b, 0x61, 0xe1, 0x82, 0x73, 0x2a, 0x4b, 0x9b, 0x92, 0xb0,
+	0x0d, 0x25, 0xa6, 0xea, 0x54, 0xe6, 0x7b, 0x2f, 0xd4, 0x31, 0xd3, 0xd0, 0x12, 0x4d, 0x28, 0xb4,
+	0xc6, 0x3f, 0x00, 0xb4, 0x4d, 0xfa, 0xc4, 0x27, 0xd3, 0x78, 0x0f, 0xc5, 0x26, 0x59, 0xd5, 0x26,
+	0xf8, 0x67, 0x1a, 0x2c, 0x46, 0xc4, 0x4f, 0xd5, 0xad, 0x3a, 0xe4, 0x7b, 0x4c, 0x18, 0xd7, 0x20,
+	0x6b, 0xc8, 0x22, 0x7a, 0x08, 0x05, 0xa1, 0x80, 0x57, 0xcf, 0xa6, 0x4c, 0x9a, 0x3c, 0xd7, 0xc9,
+	0xc3, 0xbf, 0xcc, 0x40, 0x51, 0x74, 0x74, 0x7f, 0x88, 0x9a, 0x50, 0x71, 0x79, 0xe1, 0x88, 0xf5,
+	0x47, 0x68, 0xa4, 0xa7, 0x3b, 0xa1, 0x67, 0x33, 0x46, 0x59, 0x34, 0x61, 0x64, 0xf4, 0xfb, 0x50,
+	0x92, 0x22, 0x86, 0x23, 0x5f, 0x98, 0xbc, 0x1e, 0x15, 0x10, 0xce, 0xbf, 0x67, 0x33, 0x06, 0x08,
+	0xf6, 0x83, 0x91, 0x8f, 0x3a, 0xb0, 0x24, 0x1b, 0xf3, 0xde, 0x08, 0x35, 0xb2, 0x4c, 0x4a, 0x23,
+	0x2a, 0x65, 0x7c, 0xa8, 0x9e, 0xcd, 0x18, 0x48, 0xb4, 0x57, 0x2a, 0x55, 0x95, 0xfc, 0x0b, 0xee,
+	0xbc, 0xc7, 0x54, 0xea, 0x5c, 0xd8, 0xe3, 0x2a, 0x75, 0x2e, 0xec, 0xc7, 0x45, 0xc8, 0x8b, 0x12,
+	0xfe, 0x97, 0x0c, 0x80, 0x1c, 0x8d, 0xfd, 0x21, 0xda, 0x86, 0xaa, 0x2b, 0x4a, 0x11, 0x6b, 0xbd,
+	0x93, 0x68, 0x2d, 0x31, 0x88, 0x33, 0x46, 0x45, 0x36, 0xe2, 0xca, 0x7d, 0x0f, 0xca, 0x81, 0x94,
+	0xd0, 0x60, 0x37, 0x12, 0x0c, 0x16, 0x48, 0x28, 0xc9, 0x06, 0xd4, 0x64, 0xaf, 0xe0, 0x5a, 0xd0,
+	0x3e, 0xc1, 0x66, 0xab, 0x13, 0x6c, 0x16, 0x08, 0x5c, 0x94, 0x12, 0x54, 0xab, 0xa9, 0x8a, 0x85,
+	0x66, 0xbb, 0x91, 0x60, 0xb6, 0x71, 0xc5, 0xa8, 0xe1, 0x00, 0x0a, 0xb2, 0x88, 0xff, 0x37, 0x0b,
+	0xf9, 0x2d, 0x67, 0x30, 0x34, 0x5d, 0x3a, 0x1a, 0x39, 0x97, 0x78, 0xa3, 0xbe, 0xcf, 0xcc, 0x55,
+	0xdd, 0xbc, 0x13, 0x95, 0x28, 0xd8, 0xe4, 0x5f, 0x83, 0xb1, 0x1a, 0xa2, 0x09, 0x6d, 0x2c, 0xc2,
+	0x63, 0xe6, 0x0a, 0x8d, 0x45, 0x70, 0x14, 0x4d, 0xe4, 0x42, 0xce, 0x86, 0x0b, 0x59, 0x87, 0xfc,
+	0x39, 0x71, 0xc3, 0x90, 0xfe, 0x6c, 0xc6, 0x90, 0x04, 0xf4, 0x1e, 0xcc, 0xc7, 0xc3, 0xcb, 0x9c,
+	0xe0, 0xa9, 0x76, 0xa3, 0xd1, 0xe8, 0x0e, 0x94, 0x23, 0x31, 0x2e, 0x27, 0xf8, 0x4a, 0x03, 0x25,
+	0xc4, 0x2d, 0x4b, 0xbf, 0x4a, 0xe3, 0x71, 0xf9, 0xd9, 0x8c, 0xf4, 0xac, 0xcb, 0xd2, 0xb3, 0x16,
+	0x44, 0x2b, 0x5e, 0x8c, 0x3a, 0x99, 0xef, 0x47, 0x9d, 0x0c, 0xfe, 0x3e, 0x54, 0x22, 0x06, 0xa2,
+	0x71, 0xa7, 0xf5, 0xc9, 0x8b, 0x66, 0x9b, 0x07, 0xa9, 0xa7, 0x2c, 0x2e, 0x19, 0x35, 0x8d, 0xc6,
+	0xba, 0x76, 0xeb, 0xf0, 0xb0, 0x96, 0x41, 0x15, 0x28, 0xee, 0xed, 0x77, 0x8e, 0x38, 0x57, 0x16,
+	0x3f, 0x85, 0x4a, 0xc4, 0x4a, 0x6a, 0x6c, 0x9b, 0x51, 0x62, 0x9b, 0x26, 0x63, 0x5b, 0x26, 0x8c,
+	0x6d, 0x2c, 0xcc, 0xb5, 0x5b, 0xcd, 0xc3, 0x56, 0x6d, 0xf6, 0x71, 0x15, 0xca, 0xdc, 0xbe, 0x47,
+	0x23, 0x9b, 0x86, 0xda, 0x7f, 0xd2, 0x00, 0xc2, 0xd5, 0x84, 0x36, 0x20, 0xdf, 0xe5, 0x38, 0x75,
+	0x8d, 0x39, 0xa3, 0x6b, 0x89, 0x43, 0x66, 0x48, 0x2e, 0xf4, 0x2d, 0xc8, 0x7b, 0xa3, 0x6e, 0x97,
+	0x78, 0x32, 0xe4, 0x5d, 0x8f, 0xfb, 0x43, 0xe1, 0xad, 0x0c, 0xc9, 0x47, 0x9b, 0xbc, 0x36, 0xad,
+	0xfe, 0x88, 0x05, 0xc0, 0xc9, 0x4d, 0x04, 0x1f, 0xfe, 0x7b, 0x0d, 0x4a, 0xca, 0xe4, 0xfd, 0x1d,
+	0x9d, 0xf0, 0x4d, 0x28, 0x32, 0x1d, 0x48, 0x4f, 0xb8, 0xe1, 0x82, 0x11, 0x12, 0xd0, 0xef, 0x41,
+	0x51, 0xae, 0x00, 0xe9, 0x89, 0xeb, 0xc9, 0x62, 0xf7, 0x87, 0x46, 0xc8, 0x8a, 0x77, 0x61, 0x81,
+	0x59, 0xa5, 0x4b, 0x37, 0xd7, 0xd2, 0x8e, 0xea, 0xf6, 0x53, 0x8b, 0x6d, 0x3f, 0x75, 0x28, 0x0c,
+	0x4f, 0x2f, 0x3d, 0xab, 0x6b, 0xf6, 0x85, 0x16, 0x41, 0x19, 0x7f, 0x0c, 0x48, 0x15, 0x36, 0x4d,
+	0x77, 0x71, 0x05, 0x4a, 0xcf, 0x4c, 0xef, 0x54, 0xa8, 0x84, 0x1f, 0x42, 0x85, 0x16, 0x77, 0x5f,
+	0x5e, 0x41, 0x47, 0x76, 0x38, 0x90, 0xdc, 0x53, 0xd9, 0x1c, 0xc1, 0xec, 0xa9, 0xe9, 0x9d, 0xb2,
+	0x8e, 0x56, 0x0c, 0xf6, 0x8d, 0xde, 0x83, 0x5a, 0x97, 0x77, 0xf2, 0x28, 0x76, 0x64, 0x98, 0x17,
+	0xf4, 0x60, 0x27, 0xf8, 0x29, 0x94, 0x79, 0x1f, 0xbe, 0x6a, 0x25, 0xf0, 0x02, 0xcc, 0x1f, 0xda,
+	0xe6, 0xd0, 0x3b, 0x75, 0x64, 0x74, 0xa3, 0x9d, 0xae, 0x85, 0xb4, 0xa9, 0x10, 0xdf, 0x85, 0x79,
+	0x97, 0x0c, 0x4c, 0xcb, 0xb6, 0xec, 0x93, 0xa3, 0xe3, 0x4b, 0x9f, 0x78, 0xe2, 0xc0, 0x54, 0x0d,
+	0xc8, 0x8f, 0x29, 0x95, 0xaa, 0x76, 0xdc, 0x77, 0x8e, 0x85, 0x9b, 0x63, 0xdf, 0xf8, 0xa7, 0x19,
+	0x28, 0xbf, 0x32, 0xfd, 0xae, 0x1c, 0x3a, 0xb4, 0x03, 0xd5, 0xc0, 0xb9, 0x31, 0x4a, 0x5d, 0x4b,
+	0x0a, 0xb1, 0xac, 0x8d, 0xdc, 0x4a, 0xcb, 0xe8, 0x58, 0xe9, 0xaa, 0x04, 0x26, 0xca, 0xb4, 0xbb,
+	0xa4, 0x1f, 0x88, 0xca, 0xa4, 0x8b, 0x62, 0x8c, 0xaa, 0x28, 0x95, 0x80, 0xf6, 0xa1, 0x36, 0x74,
+	0x9d, 0x13, 0x97, 0x78, 0x5e, 0x20, 0x8c, 0x87, 0x31, 0x9c, 0x20, 0xec, 0x40, 0xb0, 0x86, 0xe2,
+	0xe6, 0x87, 0x51, 0xd2, 0xe3, 0xf9, 0x70, 0x3f, 0xc3, 0x9d, 0xd3, 0x7f, 0x66, 0x00, 0x8d, 0x77,
+	0xea, 0xb7, 0xdd, 0xe2, 0xdd, 0x83, 0xaa, 0xe7, 0x9b, 0xee, 0xd8, 0x64, 0xab, 0x30, 0x6a, 0xe0,
+	0xf1, 0xdf, 0x85, 0x40, 0xa1, 0x23, 0xdb, 0xf1, 0xad, 0xd7, 0x97, 0x62, 0x97, 0x5c, 0x95, 0xe4,
+	0x3d, 0x46, 0x45, 0x2d, 0xc8, 0xbf, 0xb6, 0xfa, 0x3e, 0x71, 0xbd, 0xfa, 0x5c, 0x23, 0xbb, 0x56,
+	0xdd, 0x7c, 0xf8, 0xb6, 0x61, 0x58, 0x7f, 0xc2, 0xf8, 0x3b, 0x97, 0x43, 0x62, 0xc8, 0xb6, 0xea,
+	0xce, 0x33, 0x17, 0xd9, 0x8d, 0xdf, 0x80, 0xc2, 0x1b, 0x2a, 0x82, 0x9e, 0xb2, 0xf3, 0x7c, 0xb3,
+	0xc8, 0xca, 0xfc, 0x90, 0xfd, 0xda, 0x35, 0x4f, 0x06, 0xc4, 0xf6, 0xe5, 0x39, 0x50, 0x96, 0xf1,
+	0x3d, 0x80, 0x10, 0x86, 0xba, 0xfc, 0xbd, 0xfd, 0x83, 0x17, 0x9d, 0xda, 0x0c, 0x2a, 0x43, 0x61,
+	0x6f, 0x7f, 0xbb, 0xd5, 0x6e, 0xd1, 0xf8, 0x80, 0x37, 0xa4, 0x49, 0x23, 0x63, 0xa9, 0x62, 0x6a,
+	0x11, 0x4c, 0xbc, 0x0c, 0x4b, 0x49, 0x03, 0x48, 0xf7, 0xa2, 0x15, 0x31, 0x4b, 0xa7, 0x5a, 0x2a,
+	0x2a, 0x74, 0x26, 0xda, 0xdd, 0x3a, 0xe4, 0xf9, 0xec, 0xed, 0x89, 0xcd, 0xb9, 0x2c, 0x52, 0x43,
+	0xf0, 0xc9, 0x48, 0x7a, 0x62, 0x94, 0x82, 0x72, 0xa2, 0x7b, 0x99, 0x4b, 0x74, 0x2f, 0xe8, 0x0e,
+	0x54, 0x82, 0xd5, 0x60, 0x7a, 0x62, 0x2f, 0x50, 0x34, 0xca, 0x72, 0xa2, 0x53, 0x5a, 0xc4, 0xe8,
+	0xf9, 0xa8, 0xd1, 0xd1, 0x3d, 0xc8, 0x91, 0x73, 0x62, 0xfb, 0x5e, 0xbd, 0xc4, 0x22, 0x46, 0x45,
+	0xee, 0xdd, 0x5b, 0x94, 0x6a, 0x88, 0x4a, 0xfc, 0x5d, 0x58, 0x60, 0x67, 0xa4, 0xa7, 0xae, 0x69,
+	0xab, 0x87, 0xb9, 0x4e, 0xa7, 0x2d, 0xcc, 0x4d, 0x3f, 0x51, 0x15, 0x32, 0x3b, 0xdb, 0xc2, 0x08,
+	0x99, 0x9d, 0x6d, 0xfc, 0x63, 0x0d, 0x90, 0xda, 0x6e, 0x2a, 0x3b, 0xc7, 0x84, 0x4b, 0xf8, 0x6c,
+	0x08, 0xbf, 0x04, 0x73, 0xc4, 0x75, 0x1d, 0x97, 0x59, 0xb4, 0x68, 0xf0, 0x02, 0xbe, 0x2b, 0x74,
+	0x30, 0xc8, 0xb9, 0x73, 0x16, 0xac, 0x41, 0x2e, 0x4d, 0x0b, 0x54, 0xdd, 0x85, 0xc5, 0x08, 0xd7,
+	0x54, 0x91, 0xeb, 0x09, 0xcc, 0x33, 0x61, 0x5b, 0xa7, 0xa4, 0x7b, 0x36, 0x74, 0x2c, 0x7b, 0x0c,
+	0x8f, 0x8e, 0x5c, 0xe8, 0x60, 0x69, 0x3f, 0x78, 0xc7, 0xca, 0x01, 0xb1, 0xd3, 0x69, 0xe3, 0xcf,
+	0x60, 0x39, 0x26, 0x47, 0xaa, 0xff, 0x47, 0x50, 0xea, 0x06, 0x44, 0x4f, 0xec, 0x75, 0x6e, 0x45,
+	0x95, 0x8b, 0x37, 0x55, 0x5b, 0xe0, 0x7d, 0xb8, 0x3e, 0x26, 0x7a, 0xaa, 0x3e, 0xbf, 0x0b, 0xd7,
+	0x98, 0xc0, 0x5d, 0x42, 0x86, 0xcd, 0xbe, 0x75, 0x9e, 0x6a, 0xe9, 0x21, 0x2c, 0xc7, 0x19, 0xbf,
+	0xde, 0x79, 0x81, 0xff, 0x40, 0x20, 0x76, 0xac, 0x01, 0xe9, 0x38, 0xed, 0x74, 0xdd, 0x68, 0x34,
+	0xa3, 0x79, 0x29, 0xb1, 0xad, 0x61, 0xdf, 0xf8, 0x9f, 0x35, 0xb8, 0x3e, 0xd6, 0xfc, 0x6b, 0x9e,
+	0xc9, 0x2b, 0x00, 0x27, 0x74, 0xc9, 0x90, 0x1e, 0xad, 0xe0, 0x19, 0x15, 0x85, 0x12, 0xe8, 0x49,
+	0xfd, 0x77, 0x59, 0xe8, 0xb9, 0x24, 0xe6, 0x39, 0xfb, 0x09, 0xbc, 0xdc, 0x2d, 0x28, 0x31, 0xc2,
+	0xa1, 0x6f, 0xfa, 0x23, 0x6f, 0x6c, 0x30, 0xfe, 0x42, 0x4c, 0x7b, 0xd9, 0x68, 0xaa, 0x7e, 0x7d,
+	0x0b, 0x72, 0xec, 0x30, 0x21, 0xb7, 0xd2, 0x37, 0x12, 0xe6, 0x23, 0xd7, 0xc3, 0x10, 0x8c, 0xf8,
+	0xa7, 0x1a, 0xe4, 0x9e, 0xb3, 0x14, 0xac, 0xa2, 0xda, 0xac, 0x1c, 0x0b, 0xdb, 0x1c, 0xf0, 0xc4,
+	0x50, 0xd1, 0x60, 0xdf, 0x6c, 0xeb, 0x49, 0x88, 0xfb, 0xc2, 0x68, 0xf3, 0x2d, 0x6e, 0xd1, 0x08,
+	0xca, 0xd4, 0x66, 0xdd, 0xbe, 0x45, 0x6c, 0x9f, 0xd5, 0xce, 0xb2, 0x5a, 0x85, 0x42, 0x77, 0xcf,
+	0x96, 0xd7, 0x26, 0xa6, 0x6b, 0x8b, 0xa4, 0x69, 0xc1, 0x08, 0x09, 0xb8, 0x0d, 0x35, 0xae, 0x47,
+	0xb3, 0xd7, 0x53, 0x36, 0x98, 0x01, 0x9a, 0x16, 0x43, 0x8b, 0x48, 0xcb, 0xc4, 0xa5, 0xfd, 0x42,
+	0x83, 0x05, 0x45, 0xdc, 0x54, 0x56, 0x7d, 0x1f, 0x72, 0x3c, 0x49, 0x2d, 0x76, 0x3a, 0x4b, 0xd1,
+	0x56, 0x1c, 0xc6, 0x10, 0x3c, 0x68, 0x1d, 0xf2, 0xfc, 0x4b, 0x9e, 0x01, 0x92, 0xd9, 0x25, 0x13,
+	0xbe, 0x07, 0x8b, 0x82, 0x44, 0x06, 0x4e, 0xd2, 0xc2, 0x60, 0x83, 0x81, 0xff, 0x0c, 0x96, 0xa2,
+	0x6c, 0x53, 0x75, 0x49, 0x51, 0x32, 0x73, 0x15, 0x25, 0x9b, 0x52, 0xc9, 0x17, 0xc3, 0x9e, 0xe9,
+	0xa7, 0x29, 0x19, 0x19, 0xaf, 0x4c, 0x74, 0xbc, 0xc2, 0x0e, 0x48, 0x11, 0xdf, 0x68, 0x07, 0x3e,
+	0x92, 0xd3, 0xa1, 0x6d, 0x79, 0x81, 0x0f, 0xc7, 0x50, 0xee, 0x5b, 0x36, 0x31, 0x5d, 0x91, 0x39,
+	0xd7, 0x78, 0xe6, 0x5c, 0xa5, 0xe1, 0x2f, 0x00, 0xa9, 0x0d, 0xbf, 0x51, 0xa5, 0xef, 0x4b, 0x93,
+	0x1d, 0xb8, 0xce, 0xc0, 0x49, 0x35, 0x3b, 0xfe, 0x73, 0xb8, 0x16, 0xe3, 0xfb, 0x46, 0xd5, 0x5c,
+	0x84, 0x85, 0x6d, 0x22, 0x37, 0x34, 0xd2, 0xed, 0x7d, 0x0c, 0x48, 0x25, 0x4e, 0x15, 0xd9, 0x36,
+	0x60, 0xe1, 0xb9, 0x73, 0x4e, 0xda, 0x9c, 0x1a, 0xfa, 0x06, 0x9e, 0x87, 0x08, 0x4c, 0x11, 0x94,
+	0x29, 0xb8, 0xda, 0x60, 0x2a, 0xf0, 0x7f, 0xd7, 0xa0, 0xdc, 0xec, 0x9b, 0xee, 0x40, 0x02, 0x7f,
+	0x0f, 0x72, 0xfc, 0x74, 0x2d, 0x12, 0x5a, 0xf7, 0xa3, 0x62, 0x54, 0x5e, 0x5e, 0x68, 0x32, 0x6e,
+	0x43, 0xb4, 0xa2, 0x8a, 0x8b, 0x3b, 0xaf, 0xed, 0xd8, 0x1d, 0xd8, 0x36, 0xfa, 0x00, 0xe6, 0x4c,
+	0xda, 0x84, 0x85, 0xa2, 0x6a, 0x3c, 0xaf, 0xc1, 0xa4, 0xb1, 0x33, 0x00, 0xe7, 0xc2, 0xdf, 0x81,
+	0x92, 0x82, 0x40, 0x33, 0x37, 0x4f, 0x5b, 0x62, 0xc3, 0xde, 0xdc, 0xea, 0xec, 0xbc, 0xe4, 0x09,
+	0x9d, 0x2a, 0xc0, 0x76, 0x2b, 0x28, 0x67, 0xf0, 0xa7, 0xa2, 0x95, 0x70, 0xfb, 0xaa, 0x3e, 0x5a,
+	0x9a, 0x3e, 0x99, 0x2b, 0xe9, 0x73, 0x01, 0x15, 0xd1, 0xfd, 0x69, 0xc3, 0x18, 0x93, 0x97, 0x12,
+	0xc6, 0x14, 0xe5, 0x0d, 0xc1, 0x88, 0x7f, 0xa5, 0x41, 0x6d, 0xdb, 0x79, 0x63, 0x9f, 0xb8, 0x66,
+	0x2f, 0x58, 0x27, 0x4f, 0x62, 0x23, 0xb5, 0x1e, 0x4b, 0x8e, 0xc6, 0xf8, 0x43, 0x42, 0x6c, 0xc4,
+	0xea, 0x61, 0xda, 0x90, 0xc7, 0x42, 0x59, 0xc4, 0x1f, 0xc1, 0x7c, 0xac, 0x11, 0xb5, 0xfd, 0xcb,
+	0x66, 0x7b, 0x67, 0x9b, 0xda, 0x9a, 0x25, 0xd6, 0x5a, 0x7b, 0xcd, 0xc7, 0xed, 0x96, 0xb8, 0x40,
+	0x6a, 0xee, 0x6d, 0xb5, 0xda, 0xb5, 0x0c, 0xee, 0xc2, 0x82, 0x02, 0x3f, 0xed, 0xcd, 0x40, 0x8a,
+	0x76, 0xf3, 0x50, 0x11, 0xd1, 0x5e, 0x2c, 0xca, 0x7f, 0xcb, 0x40, 0x55, 0x52, 0xbe, 0x1e, 0x4c,
+	0xb4, 0x0c, 0xb9, 0xde, 0xf1, 0xa1, 0xf5, 0x85, 0xbc, 0x39, 0x12, 0x25, 0x4a, 0xef, 0x73, 0x1c,
+	0x7e, 0x7d, 0x2b, 0x4a, 0x34, 0x8c, 0xd3, 0x8b, 0xdc, 0x1d, 0xbb, 0x47, 0x2e, 0xd8, 0xa6, 0x60,
+	0xd6, 0x08, 0x09, 0x2c, 0xc3, 0x24, 0xae, 0x79, 0xeb, 0xb9, 0xe8, 0xb5, 0x2f, 0x7a, 0x00, 0x35,
+	0xfa, 0xdd, 0x1c, 0x0e, 0xfb, 0x16, 0xe9, 0x71, 0x01, 0x79, 0xc6, 0x33, 0x46, 0xa7, 0xe8, 0xec,
+	0x2c, 0xe2, 0xd5, 0x0b, 0x2c, 0x2c, 0x89, 0x12, 0x6a, 0x40, 0x89, 0xeb, 0xb7, 0x63, 0xbf, 0xf0,
+	0x08, 0xbb, 0xfb, 0xcc, 0x1a, 0x2a, 0x29, 0xba, 0xcd, 0x80, 0xf8, 0x36, 0x63, 0x11, 0x16, 0x9a,
+	0x23, 0xff, 0xb4, 0x65, 0xd3, 0x58, 0x21, 0xad, 0xbc, 0x04, 0x88, 0x12, 0xb7, 0x2d, 0x4f, 0xa5,
+	0x0a, 0xd6, 0xe8, 0x80, 0xb4, 0x60, 0x91, 0x12, 0x89, 0xed, 0x5b, 0x5d, 0x25, 0xae, 0xca, 0x9d,
+	0x97, 0x16, 0xdb, 0x79, 0x99, 0x9e, 0xf7, 0xc6, 0x71, 0x7b, 0xc2, 0xe6, 0x41, 0x19, 0xff, 0xa3,
+	0xc6, 0x21, 0x5f, 0x78, 0x91, 0xed, 0xd3, 0x6f, 0x29, 0x06, 0x7d, 0x08, 0x79, 0x67, 0xc8, 0x6e,
+	0xf8, 0x45, 0x1a, 0x66, 0x79, 0x9d, 0xbf, 0x09, 0x58, 0x17, 0x82, 0xf7, 0x79, 0xad, 0x21, 0xd9,
+	0xd0, 0x7d, 0xa8, 0xd2, 0x5c, 0x18, 0xe9, 0x1d, 0x48, 0x99, 0xfc, 0xe4, 0x17, 0xa3, 0xe2, 0xb5,
+	0x50, 0xbf, 0xa7, 0xc4, 0x9f, 0xa0, 0x1f, 0x7e, 0x08, 0xd7, 0x24, 0xa7, 0xb8, 0x9d, 0x98, 0xc0,
+	0xfc, 0x06, 0x6e, 0x49, 0xe6, 0xad, 0x53, 0x9a, 0xad, 0x91, 0x80, 0xbf, 0xab, 0x05, 0xc6, 0xfb,
+	0x93, 0x4d, 0xec, 0xcf, 0x63, 0xa8, 0x07, 0xfd, 0x61, 0x27, 0x6b, 0xa7, 0xaf, 0x2a, 0x3a, 0xf2,
+	0xc4, 0x7a, 0x2a, 0x1a, 0xec, 0x9b, 0xd2, 0x5c, 0xa7, 0x1f, 0x6c, 0xa5, 0xe9, 0x37, 0xde, 0x82,
+	0x1b, 0x52, 0x86, 0x38, 0xf3, 0x46, 0x85, 0x8c, 0x29, 0x9e, 0x24, 0x44, 0x18, 0x96, 0x36, 0x9d,
+	0x3c, 0xf0, 0x2a, 0x67, 0x74, 0x08, 0x98, 0x4c, 0x4d, 0x91, 0x79, 0x0d, 0x16, 0xa5, 0x62, 0xca,
+	0x6e, 0x49, 0x92, 0xa9, 0x00, 0x95, 0x2c, 0x06, 0x8c, 0x92, 0xc7, 0x06, 0x6c, 0x4c, 0xf4, 0x0f,
+	0x60, 0x25, 0x50, 0x82, 0xda, 0xed, 0x80, 0xb8, 0x03, 0xcb, 0xf3, 0x94, 0xbc, 0x77, 0x52, 0xc7,
+	0xef, 0xc3, 0xec, 0x90, 0x88, 0x20, 0x54, 0xda, 0x44, 0x72, 0x52, 0x2a, 0x8d, 0x59, 0x3d, 0xee,
+	0xc1, 0x6d, 0x29, 0x9d, 0x5b, 0x34, 0x51, 0x7c, 0x5c, 0x29, 0x99, 0x0d, 0xcc, 0xa4, 0x64, 0x03,
+	0xb3, 0xb1, 0xbb, 0x98, 0x8f, 0x01, 0xa9, 0x6b, 0x7e, 0xaa, 0xcd, 0xc5, 0x2e, 0x2c, 0x46, 0x5c,
+	0xc5, 0x54, 0xc2, 0xfe, 0x5a, 0x78, 0x81, 0xaf, 0xca, 0xc3, 0x13, 0xd6, 0x43, 0x79, 0xd1, 0x21,
+	0x8b, 0x74, 0xd7, 0x4c, 0x07, 0xc0, 0x50, 0x73, 0xa1, 0xb3, 0x46, 0x84, 0x86, 0x8f, 0x61, 0x29,
+	0xea, 0xd7, 0xa6, 0xd2, 0x65, 0x09, 0xe6, 0x7c, 0xe7, 0x8c, 0xc8, 0x58, 0xc3, 0x0b, 0x78, 0x37,
+	0x9c, 0xa6, 0x53, 0x9f, 0xf1, 0xb0, 0x19, 0x0a, 0x63, 0xab, 0x63, 0x5a, 0x7d, 0xe9, 0xc4, 0x92,
+	0x67, 0x20, 0x5e, 0xc0, 0x7b, 0xb0, 0x1c, 0xf7, 0x6c, 0x53, 0xa9, 0xfc, 0x12, 0x56, 0xa4, 0xbc,
+	0xb8, 0xf3, 0x9b, 0x4a, 0xee, 0x27, 0xa1, 0x5f, 0x52, 0x7c, 0xdb, 0x54, 0x22, 0x0d, 0xd0, 0x93,
+	0x5c, 0xdd, 0x57, 0xb1, 0x74, 0x02, 0xcf, 0x37, 0x95, 0x30, 0x2f, 0x14, 0x36, 0xfd, 0xf0, 0x87,
+	0xee, 0x2a, 0x3b, 0xd1, 0x5d, 0x89, 0x45, 0x12, 0x3a, 0xd4, 0xaf, 0x61, 0xd2, 0x09, 0x8c, 0xd0,
+	0x97, 0x4f, 0x8b, 0x41, 0xc3, 0x59, 0x80, 0xc1, 0x0a, 0x72, 0x62, 0xab, 0x11, 0x60, 0xaa, 0xc1,
+	0x78, 0x15, 0xba, 0xf1, 0xb1, 0x20, 0x31, 0x95, 0xe0, 0x4f, 0xa1, 0x91, 0x1e, 0x1f, 0xa6, 0x91,
+	0xfc, 0x60, 0x0b, 0x8a, 0xc1, 0x61, 0x48, 0x79, 0x6f, 0x56, 0x82, 0xfc, 0xde, 0xfe, 0xe1, 0x41,
+	0x73, 0xab, 0xc5, 0x1f, 0x9c, 0x6d, 0xed, 0x1b, 0xc6, 0x8b, 0x83, 0x4e, 0x2d, 0x83, 0x10, 0x54,
+	0x0f, 0xdb, 0xfb, 0xaf, 0x8e, 0x5e, 0x35, 0xdb, 0x47, 0x4f, 0x0e, 0x3f, 0xdb, 0xdb, 0xaa, 0x65,
+	0x37, 0x7f, 0x9d, 0x85, 0xcc, 0xee, 0x4b, 0xf4, 0x19, 0xcc, 0xf1, 0x17, 0x19, 0x13, 0x9e, 0xe1,
+	0xe8, 0x93, 0x1e, 0x9d, 0xe0, 0xeb, 0x3f, 0xfe, 0xaf, 0x5f, 0xff, 0x3c, 0xb3, 0x80, 0xcb, 0x1b,
+	0xe7, 0xdf, 0xde, 0x38, 0x3b, 0xdf, 0x60, 0xa1, 0xeb, 0x91, 0xf6, 0x00, 0x7d, 0x02, 0x59, 0xfa,
+	0x86, 0x24, 0xf5, 0x79, 0x8e, 0x9e, 0xfe, 0x0e, 0x05, 0x5f, 0x63, 0x42, 0xe7, 0x31, 0x08, 0xa1,
+	0xc3, 0x91, 0x4f, 0x45, 0xfe, 0x10, 0x4a, 0xea, 0x2b, 0x92, 0xb7, 0xbe, 0xd9, 0xd1, 0xdf, 0xfe,
+	0x42, 0x05, 0xdf, 0x62, 0x50, 0xd7, 0x31, 0x12, 0x50, 0xfc, 0x9d, 0x8b, 0xda, 0x8b, 0xce, 0x85,
+	0x8d, 0x52, 0x5f, 0xf4, 0xe8, 0xe9, 0x8f, 0x56, 0xc6, 0x7a, 0xe1, 0x5f, 0xd8, 0x54, 0xe4, 0x9f,
+	0x88, 0xf7, 0x2a, 0x5d, 0x1f, 0xdd, 0x4e, 0x78, 0xaf, 0xa0, 0xde, 0xcc, 0xeb, 0x8d, 0x74, 0x06,
+	0x01, 0x72, 0x93, 0x81, 0x2c, 0xe3, 0x05, 0x01, 0xd2, 0x0d, 0x58, 0x1e, 0x69, 0x0f, 0x36, 0xbb,
+	0x30, 0xc7, 0x6e, 0xbd, 0xd0, 0xe7, 0xf2, 0x43, 0x4f, 0xb8, 0xfe, 0x4b, 0x19, 0xe8, 0xc8, 0x7d,
+	0x19, 0x5e, 0x62, 0x40, 0x55, 0x5c, 0xa4, 0x40, 0xec, 0xce, 0xeb, 0x91, 0xf6, 0x60, 0x4d, 0xfb,
+	0x50, 0xdb, 0xfc, 0xd5, 0x1c, 0xcc, 0xb1, 0x74, 0x2f, 0x3a, 0x03, 0x08, 0x6f, 0x80, 0xe2, 0xbd,
+	0x1b, 0xbb, 0x53, 0xd2, 0x1b, 0xe9, 0x0c, 0x02, 0x54, 0x67, 0xa0, 0x4b, 0x78, 0x9e, 0x82, 0xb2,
+	0x2c, 0xf2, 0x06, 0x4b, 0x8c, 0x53, 0x3b, 0xfe, 0x8d, 0x26, 0xb2, 0xdd, 0x7c, 0x7d, 0xa1, 0x24,
+	0x69, 0x91, 0x6b, 0x20, 0x7d, 0x75, 0x02, 0x87, 0x00, 0xfc, 0x2e, 0x03, 0xdc, 0xc0, 0xb5, 0x10,
+	0xd0, 0x65, 0x1c, 0x8f, 0xb4, 0x07, 0x9f, 0xd7, 0xf1, 0xa2, 0xb0, 0x72, 0xac, 0x06, 0xfd, 0x08,
+	0xaa, 0xd1, 0x6b, 0x0e, 0x74, 0x27, 0x01, 0x2b, 0x7e, 0x5b, 0xa2, 0xdf, 0x9d, 0xcc, 0x24, 0x74,
+	0x5a, 0x61, 0x3a, 0x09, 0x70, 0x8e, 0x7c, 0x46, 0xc8, 0xd0, 0xa4, 0x4c, 0x62, 0x0c, 0xd0, 0x3f,
+	0x68, 0x30, 0x1f, 0xbb, 0xb7, 0x40, 0x49, 0xd2, 0xc7, 0x6e, 0x45, 0xf4, 0x7b, 0x6f, 0xe1, 0x12,
+	0x4a, 0xfc, 0x21, 0x53, 0xe2, 0x23, 0xbc, 0x14, 0x2a, 0xe1, 0x5b, 0x03, 0xe2, 0x3b, 0x42, 0x8b,
+	0xcf, 0x6f, 0xe2, 0xeb, 0x11, 0xe3, 0x44, 0x6a, 0xc3, 0xc1, 0x62, 0x3f, 0x5e, 0xe2, 0x60, 0x45,
+	0xee, 0x32, 0xf4, 0xd5, 0x09, 0x1c, 0xe9, 0x83, 0xc5, 0x7e, 0xbd, 0xa4, 0xc1, 0x0a, 0x6a, 0x36,
+	0xff, 0x6f, 0x16, 0xf2, 0x5b, 0xfc, 0x9d, 0x38, 0x72, 0xa0, 0x18, 0xa4, 0xee, 0xd1, 0x4a, 0x52,
+	0xee, 0x31, 0x3c, 0xea, 0xe8, 0xb7, 0x53, 0xeb, 0x85, 0x42, 0xab, 0x4c, 0xa1, 0x77, 0xf0, 0x32,
+	0x45, 0x16, 0x4f, 0xd1, 0x37, 0x78, 0x82, 0x6b, 0xc3, 0xec, 0xf5, 0xa8, 0x21, 0xfe, 0x14, 0xca,
+	0x6a, 0x6e, 0x1d, 0xad, 0x26, 0xc9, 0x8c, 0xa4, 0xe7, 0x75, 0x3c, 0x89, 0x45, 0x20, 0xdf, 0x65,
+	0xc8, 0x2b, 0xf8, 0x46, 0x02, 0xb2, 0xcb, 0x58, 0x23, 0xe0, 0x3c, 0x2f, 0x9e, 0x0c, 0x1e, 0x49,
+	0xbb, 0xeb, 0x78, 0x12, 0xcb, 0x15, 0xc0, 0x47, 0x8c, 0x95, 0x82, 0x7b, 0x00, 0x61, 0x76, 0x1b,
+	0x25, 0xda, 0x52, 0x39, 0xeb, 0xe9, 0x8d, 0x74, 0x06, 0x01, 0x8b, 0x19, 0xac, 0x98, 0x77, 0x31,
+	0xd8, 0xbe, 0xe5, 0xf9, 0x7c, 0x61, 0x56, 0x22, 0xe9, 0x6a, 0x94, 0xd8, 0x9f, 0x68, 0xce, 0x5b,
+	0xbf, 0x33, 0x91, 0x47, 0xa0, 0xdf, 0x63, 0xe8, 0xb7, 0xb1, 0x9e, 0x80, 0x3e, 0xe4, 0xbc, 0x74,
+	0xb2, 0xfd, 0x7f, 0x0e, 0x4a, 0xcf, 0x4d, 0xcb, 0xf6, 0x89, 0x4d, 0xaf, 0xe9, 0xd1, 0x31, 0xcc,
+	0xb1, 0xe8, 0x1d, 0x77, 0xc4, 0x6a, 0x2a, 0x57, 0x7f, 0x27, 0xb1, 0x4e, 0x00, 0x37, 0x18, 0xb0,
+	0x8e, 0xaf, 0x51, 0xe0, 0x41, 0x28, 0x7a, 0x83, 0xa5, 0x27, 0x69, 0xa7, 0x5f, 0x43, 0x4e, 0xdc,
+	0x00, 0xc6, 0x04, 0x45, 0x12, 0x42, 0xfa, 0xcd, 0xe4, 0xca, 0xa4, 0xb9, 0xac, 0xc2, 0x78, 0x8c,
+	0x8f, 0xe2, 0x9c, 0x03, 0x84, 0x79, 0xf7, 0xf8, 0x88, 0x8e, 0xa5, 0xe9, 0xf5, 0x46, 0x3a, 0x43,
+	0x92, 0x4d, 0x55, 0xcc, 0x5e, 0xc0, 0x4b, 0x71, 0xff, 0x18, 0x66, 0xe9, 0x3b, 0x2b, 0x14, 0x8b,
+	0xbd, 0xca, 0xfb, 0x31, 0x5d, 0x4f, 0xaa, 0x12, 0x28, 0xb7, 0x19, 0xca, 0x0d, 0xbc, 0x14, 0x47,
+	0xa1, 0x89, 0x17, 0x2a, 0xbf, 0x07, 0x39, 0xfe, 0x9c, 0x2c, 0x6e, 0xbf, 0xc8, 0x93, 0x34, 0xfd,
+	0x66, 0x72, 0xe5, 0x55, 0x51, 0x86, 0x50, 0x90, 0xef, 0xb7, 0x50, 0xec, 0x32, 0x3f, 0xf6, 0xd6,
+	0x4b, 0x5f, 0x49, 0xab, 0x16, 0x58, 0x77, 0x18, 0xd6, 0x2d, 0x5c, 0x1f, 0x1b, 0x2b, 0xc1, 0xf9,
+	0x48, 0x7b, 0xf0, 0xa1, 0x86, 0x7e, 0x04, 0x10, 0x5e, 0x55, 0x8c, 0xad, 0xc0, 0xf8, 0xad, 0x87,
+	0xde, 0x48, 0x67, 0x10, 0xb8, 0xeb, 0x0c, 0x77, 0x0d, 0xdf, 0x89, 0xe3, 0xfa, 0xae, 0x69, 0x7b,
+	0xaf, 0x89, 0xfb, 0x01, 0xcf, 0xbc, 0x7a, 0xa7, 0xd6, 0x90, 0x76, 0xd9, 0x85, 0x62, 0x90, 0x89,
+	0x8e, 0x7b, 0xdb, 0x78, 0x86, 0x5c, 0xbf, 0x9d, 0x5a, 0x9f, 0xe4, 0x76, 0x22, 0xb3, 0x45, 0xb2,
+	0xd2, 0x05, 0xf8, 0x8b, 0x1a, 0xcc, 0xd2, 0x9d, 0x38, 0xdd, 0x9c, 0x84, 0xb9, 0x94, 0x78, 0xef,
+	0xc7, 0x32, 0xab, 0x7a, 0x23, 0x9d, 0x21, 0x69, 0x73, 0x42, 0x0f, 0x5e, 0x1b, 0x3c, 0x6d, 0x41,
+	0x7b, 0xea, 0x40, 0x49, 0x49, 0xb6, 0xa0, 0x04, 0x61, 0xd1, 0x94, 0xad, 0xbe, 0x3a, 0x81, 0x43,
+	0xe0, 0xbd, 0xc3, 0xf0, 0xae, 0xe1, 0x5a, 0x80, 0xd7, 0xb3, 0x3c, 0x09, 0x28, 0x7a, 0x27, 0xd6,
+	0x7d, 0x42, 0xef, 0xa2, 0x6b, 0xbf, 0x91, 0xce, 0x90, 0xda, 0xbb, 0x70, 0xe1, 0xbf, 0x81, 0xb2,
+	0x9a, 0x72, 0x41, 0x09, 0xca, 0xc7, 0xd2, 0xcc, 0x3a, 0x9e, 0xc4, 0x92, 0xe4, 0xd9, 0x18, 0xa4,
+	0xa9, 0xb0, 0x51, 0xe0, 0x3e, 0xe4, 0x45, 0x0e, 0x26, 0xc9, 0xa4, 0xd1, 0x94, 0xb4, 0xbe, 0x3a,
+	0x81, 0x23, 0x69, 0xf7, 0xcc, 0x10, 0x47, 0x5e, 0x18, 0xab, 0x05, 0xda, 0x53, 0xe2, 0xa7, 0xa1,
+	0x85, 0xd9, 0x4d, 0x7d, 0x75, 0x02, 0xc7, 0x64, 0xb4, 0x13, 0xe2, 0x0b, 0x7f, 0x20, 0x8f, 0xce,
+	0x28, 0x45, 0x98, 0x1a, 0x1f, 0xf1, 0x24, 0x96, 0xa4, 0xc3, 0x4d, 0x08, 0x28, 0x83, 0xe3, 0x05,
+	0x40, 0x98, 0x21, 0x42, 0x77, 0x92, 0x05, 0x46, 0x12, 0xad, 0xfa, 0xdd, 0xc9, 0x4c, 0x49, 0xbe,
+	0x2f, 0xc4, 0xe5, 0x67, 0x2b, 0x8a, 0xfc, 0x33, 0x0d, 0xd0, 0x78, 0x32, 0x09, 0x3d, 0x4c, 0x96,
+	0x9e, 0x98, 0x6f, 0xd7, 0xdf, 0xbf, 0x1a, 0x73, 0x52, 0x38, 0x0b, 0x55, 0xea, 0x32, 0xee, 0xe1,
+	0x1b, 0xaa, 0xd4, 0x5f, 0x6a, 0x50, 0x89, 0x64, 0xa2, 0xd0, 0xfd, 0x94, 0x31, 0x8d, 0xa5, 0xe1,
+	0xf5, 0x77, 0xdf, 0xca, 0x97, 0xb4, 0x95, 0x57, 0x66, 0x80, 0x3c, 0xd3, 0xfc, 0x44, 0x83, 0x6a,
+	0x34, 0x73, 0x85, 0x52, 0x64, 0x8f, 0xa5, 0xf1, 0xf5, 0xb5, 0xb7, 0x33, 0x4e, 0x1e, 0x9e, 0xf0,
+	0x38, 0xd3, 0x87, 0xbc, 0xc8, 0x75, 0x25, 0x4d, 0xfc, 0xe8, 0x05, 0x80, 0xbe, 0x3a, 0x81, 0x23,
+	0x75, 0xe2, 0xbb, 0x4e, 0x9f, 0x28, 0xcb, 0x4c, 0x24, 0xc3, 0xd2, 0xd0, 0x26, 0x2f, 0xb3, 0x58,
+	0x26, 0x2d, 0x0d, 0x2d, 0x5c, 0x66, 0x32, 0x0b, 0x86, 0x52, 0x84, 0xbd, 0x65, 0x99, 0xc5, 0x93,
+	0x68, 0x09, 0xcb, 0x8c, 0x01, 0x2a, 0xcb, 0x2c, 0xcc, 0x57, 0x25, 0x2d, 0xb3, 0xb1, 0xfb, 0x0c,
+	0xfd, 0xee, 0x64, 0xa6, 0xd4, 0x71, 0x64, 0xb8, 0x91, 0x65, 0xb6, 0x98, 0x90, 0xda, 0x42, 0xef,
+	0xa7, 0x18, 0x31, 0xf1, 0x9a, 0x44, 0xff, 0xe0, 0x8a, 0xdc, 0xa9, 0x73, 0x9c, 0x9b, 0x5f, 0xce,
+	0xf1, 0xbf, 0xd5, 0x60, 0x29, 0x29, 0x2d, 0x86, 0x52, 0x70, 0x52, 0xae, 0x57, 0xf4, 0xf5, 0xab,
+	0xb2, 0x4f, 0xb6, 0x56, 0x30, 0xeb, 0x1f, 0xd7, 0xfe, 0xf5, 0xcb, 0x15, 0xed, 0x3f, 0xbe, 0x5c,
+	0xd1, 0xfe, 0xfb, 0xcb, 0x15, 0xed, 0xef, 0xfe, 0x67, 0x65, 0xe6, 0x38, 0xc7, 0xfe, 0xf9, 0xf8,
+	0xdb, 0xbf, 0x19, 0x00, 0x41, 0x0b, 0x97, 0x91, 0x01, 0x3d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -146,6 +146,8 @@ func epHealthCommandFunc(cmd *cobra.Command, args []string) {
 							eh.Error = eh.Error + "NOSPACE "
 						case etcdserverpb.AlarmType_CORRUPT:
 							eh.Error = eh.Error + "CORRUPT "
+						case etcdserverpb.AlarmType_SLOW_WAL_FSYNC:
+							eh.Error = eh.Error + "SLOW_WAL_FSYNC "
 						default:
 							eh.Error = eh.Error + "UNKNOWN "
 						}
@@ -196,6 +196,14 @@ type Config struct {
 	// logical clock from assigning the timestamp and then forwarding the data
 	// to the leader.
 	DisableProposalForwarding bool
+
+	// CampaignGuard, if set, is consulted before the local node starts a
+	// campaign on election timeout or on request. Returning false vetoes the
+	// campaign. The node keeps voting for other candidates, and a leader is
+	// not affected. Campaigns for a leadership transfer to the node are not
+	// vetoed, since the old leader stops accepting proposals until the
+	// transfer completes or times out.
+	CampaignGuard func() bool
 }
 
 func (c *Config) validate() error {
@@ -302,6 +310,7 @@ type raft struct {
 	// when raft changes its state to follower or candidate.
 	randomizedElectionTimeout int
 	disableProposalForwarding bool
+	campaignGuard             func() bool
 
 	tick func()
 	step stepFunc
@@ -340,6 +349,7 @@ func newRaft(c *Config) *raft {
 		preVote:                   c.PreVote,
 		readOnly:                  newReadOnly(c.ReadOnlyOption),
 		disableProposalForwarding: c.DisableProposalForwarding,
+		campaignGuard:             c.CampaignGuard,
 	}
 
 	cfg, prs, err := confchange.Restore(confchange.Changer{
@@ -761,6 +771,10 @@ func (r *raft) hup(t CampaignType) {
 		r.logger.Warningf("%x is unpromotable and can not campaign", r.id)
 		return
 	}
+	if t != campaignTransfer && r.campaignGuard != nil && !r.campaignGuard() {
+		r.logger.Debugf("%x ignoring MsgHup because campaigning is vetoed", r.id)
+		return
+	}
 	ents, err := r.raftLog.slice(r.raftLog.applied+1, r.raftLog.committed+1, noLimit)
 	if err != nil {
 		r.logger.Panicf("unexpected error getting unapplied entries (%v)", err)
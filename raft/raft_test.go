@@ -339,6 +339,73 @@ func TestLearnerElectionTimeout(t *testing.T) {
 	}
 }
 
+// TestCampaignGuard verifies that a node does not campaign while its campaign
+// guard vetoes it, still votes for other candidates, and campaigns once allowed.
+func TestCampaignGuard(t *testing.T) {
+	allow := false
+	cfg := newTestConfig(1, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
+	cfg.CampaignGuard = func() bool { return allow }
+	n1 := newRaft(cfg)
+	n2 := newTestRaft(2, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
+	n3 := newTestRaft(3, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+	n3.becomeFollower(1, None)
+
+	nt := newNetwork(n1, n2, n3)
+
+	setRandomizedElectionTimeout(n1, n1.electionTimeout)
+	for i := 0; i < n1.electionTimeout; i++ {
+		n1.tick()
+	}
+	if n1.state != StateFollower {
+		t.Errorf("peer 1 state after election timeout: %s, want %s", n1.state, StateFollower)
+	}
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	if n1.state != StateFollower {
+		t.Errorf("peer 1 state after MsgHup: %s, want %s", n1.state, StateFollower)
+	}
+
+	// the vetoed node still votes for other candidates
+	nt.send(pb.Message{From: 2, To: 2, Type: pb.MsgHup})
+	if n2.state != StateLeader {
+		t.Errorf("peer 2 state: %s, want %s", n2.state, StateLeader)
+	}
+
+	allow = true
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	if n1.state != StateLeader {
+		t.Errorf("peer 1 state once allowed: %s, want %s", n1.state, StateLeader)
+	}
+}
+
+// TestCampaignGuardAllowsLeaderTransfer ensures that a campaign guard does
+// not veto the campaign of a leadership transferee.
+func TestCampaignGuardAllowsLeaderTransfer(t *testing.T) {
+	n1 := newTestRaft(1, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
+	cfg := newTestConfig(2, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
+	cfg.CampaignGuard = func() bool { return false }
+	n2 := newRaft(cfg)
+	n3 := newTestRaft(3, 10, 1, newTestMemoryStorage(withPeers(1, 2, 3)))
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+	n3.becomeFollower(1, None)
+
+	nt := newNetwork(n1, n2, n3)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	if n1.state != StateLeader {
+		t.Fatalf("peer 1 state: %s, want %s", n1.state, StateLeader)
+	}
+
+	nt.send(pb.Message{From: 2, To: 1, Type: pb.MsgTransferLeader})
+	checkLeaderTransferState(t, n1, StateFollower, 2)
+	if n2.state != StateLeader {
+		t.Errorf("peer 2 state: %s, want %s", n2.state, StateLeader)
+	}
+}
+
 // TestLearnerPromotion verifies that the learner should not election until
 // it is promoted to a normal peer.
 func TestLearnerPromotion(t *testing.T) {
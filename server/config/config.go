@@ -64,12 +64,19 @@ type ServerConfig struct {
 
 	// BackendFreelistType is the type of the backend boltdb freelist.
 	BackendFreelistType bolt.FreelistType
+	// BackendAccessPattern is the expected access pattern of the backend
+	// mmap, "sequential" or "random". Empty keeps the default behavior.
+	BackendAccessPattern string
 
 	InitialPeerURLsMap  types.URLsMap
 	InitialClusterToken string
 	NewCluster          bool
 	PeerTLSInfo         transport.TLSInfo
 
+	// ManualBootstrap is true to leave a new single-node cluster unbootstrapped
+	// until EtcdServer.BootstrapSingleNode is called.
+	ManualBootstrap bool
+
 	CORS map[string]struct{}
 
 	// HostWhitelist lists acceptable hostnames from client requests.
@@ -120,8 +127,46 @@ type ServerConfig struct {
 	// MaxRequestBytes is the maximum request size to send over raft.
 	MaxRequestBytes uint
 
+	// MaxConcurrentWatchStreams is the maximum number of watch streams the
+	// server accepts at once. New streams beyond the limit are rejected to
+	// keep the apply loop's event fan-out bounded. 0 means unlimited.
+	MaxConcurrentWatchStreams uint
+
 	WarningApplyDuration time.Duration
 
+	// WALFsyncAlarmThreshold is the average WAL fsync duration above which
+	// the WAL is considered chronically slow and the SLOW_WAL_FSYNC alarm is
+	// raised. 0 disables detection.
+	WALFsyncAlarmThreshold time.Duration
+	// WALFsyncAlarmTransferLeadership is true to transfer leadership away
+	// from the local member when the SLOW_WAL_FSYNC alarm is raised.
+	WALFsyncAlarmTransferLeadership bool
+
+	// AuditProposals is true to log every proposal before it enters raft.
+	AuditProposals bool
+	// AuditProposalPayloads is true to include put and txn values in
+	// audited proposals. Values are redacted otherwise.
+	AuditProposalPayloads bool
+
+	// ResultCacheSize is the maximum number of applied request results
+	// retained by request id. 0 disables result retention.
+	ResultCacheSize uint
+	// ResultCacheTTL is how long applied request results are retained.
+	ResultCacheTTL time.Duration
+
+	// NospaceAlarmCheckInterval is how often the leader re-evaluates the
+	// backend size against the quota to clear the NOSPACE alarm. 0 disables it.
+	NospaceAlarmCheckInterval time.Duration
+	// NospaceAlarmLowWatermark is the fraction of the backend quota the backend
+	// size must fall below before the NOSPACE alarm is cleared. Values outside
+	// (0, 1) use the default.
+	NospaceAlarmLowWatermark float64
+
+	// StaleTermEntryAction is how a committed entry whose term predates the
+	// latest snapshot or membership change is handled, "process" or "skip".
+	// Empty processes the entry.
+	StaleTermEntryAction string
+
 	StrictReconfigCheck bool
 
 	// ClientCertAuthEnabled is true when cert has been signed by the client CA.
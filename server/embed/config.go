@@ -36,6 +36,7 @@ import (
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
 
 	bolt "go.etcd.io/bbolt"
 	"go.uber.org/multierr"
@@ -54,6 +55,8 @@ const (
 	DefaultMaxWALs               = 5
 	DefaultMaxTxnOps             = uint(128)
 	DefaultWarningApplyDuration  = 100 * time.Millisecond
+	DefaultResultCacheTTL        = 5 * time.Minute
+	DefaultNospaceAlarmWatermark = etcdserver.DefaultNospaceAlarmLowWatermark
 	DefaultMaxRequestBytes       = 1.5 * 1024 * 1024
 	DefaultGRPCKeepAliveMinTime  = 5 * time.Second
 	DefaultGRPCKeepAliveInterval = 2 * time.Hour
@@ -301,6 +304,12 @@ type Config struct {
 	//	}
 	//	embed.StartEtcd(cfg)
 	ServiceRegister func(*grpc.Server) `json:"-"`
+	// ManualBootstrap leaves a new single-node cluster unbootstrapped and
+	// unstarted by StartEtcd, so that it can be bootstrapped with
+	// Etcd.Server.BootstrapSingleNode. It is only used for embedding etcd
+	// into other applications. A member restarted on an existing data dir
+	// is started as usual.
+	ManualBootstrap bool `json:"-"`
 
 	AuthToken  string `json:"auth-token"`
 	BcryptCost uint   `json:"bcrypt-cost"`
@@ -324,6 +333,37 @@ type Config struct {
 	// ExperimentalBootstrapDefragThresholdMegabytes is the minimum number of megabytes needed to be freed for etcd server to
 	// consider running defrag during bootstrap. Needs to be set to non-zero value to take effect.
 	ExperimentalBootstrapDefragThresholdMegabytes uint `json:"experimental-bootstrap-defrag-threshold-megabytes"`
+	// ExperimentalMaxConcurrentWatchStreams is the maximum number of watch streams
+	// the server accepts at once. 0 means unlimited.
+	ExperimentalMaxConcurrentWatchStreams uint `json:"experimental-max-concurrent-watch-streams"`
+	// ExperimentalWALFsyncAlarmThreshold is the average WAL fsync duration above which
+	// an alarm is raised. Needs to be set to non-zero value to take effect.
+	ExperimentalWALFsyncAlarmThreshold time.Duration `json:"experimental-wal-fsync-alarm-threshold"`
+	// ExperimentalWALFsyncAlarmTransferLeadership enables transferring leadership away
+	// from the local member once the WAL fsync alarm is raised.
+	ExperimentalWALFsyncAlarmTransferLeadership bool `json:"experimental-wal-fsync-alarm-transfer-leadership"`
+	// ExperimentalAuditProposals enables logging every proposal with its request id,
+	// kind and user before it enters raft.
+	ExperimentalAuditProposals bool `json:"experimental-audit-proposals"`
+	// ExperimentalAuditProposalPayloads includes values in audited proposals.
+	ExperimentalAuditProposalPayloads bool `json:"experimental-audit-proposal-payloads"`
+	// ExperimentalResultCacheSize is the maximum number of applied request results
+	// retained by request id. Needs to be set to non-zero value to take effect.
+	ExperimentalResultCacheSize uint `json:"experimental-result-cache-size"`
+	// ExperimentalResultCacheTTL is how long applied request results are retained.
+	ExperimentalResultCacheTTL time.Duration `json:"experimental-result-cache-ttl"`
+	// ExperimentalBackendAccessPattern is the expected access pattern of the backend
+	// mmap ("sequential" or "random"). Empty keeps the default behavior.
+	ExperimentalBackendAccessPattern string `json:"experimental-backend-access-pattern"`
+	// ExperimentalNospaceAlarmCheckInterval is how often the leader checks whether
+	// the NOSPACE alarm can be cleared. Needs to be set to non-zero value to take effect.
+	ExperimentalNospaceAlarmCheckInterval time.Duration `json:"experimental-nospace-alarm-check-interval"`
+	// ExperimentalNospaceAlarmLowWatermark is the fraction of the backend quota
+	// the backend size must fall below before the NOSPACE alarm is cleared.
+	ExperimentalNospaceAlarmLowWatermark float64 `json:"experimental-nospace-alarm-low-watermark"`
+	// ExperimentalStaleTermEntryAction is how a committed entry whose term predates
+	// the latest snapshot or membership change is handled ("process" or "skip").
+	ExperimentalStaleTermEntryAction string `json:"experimental-stale-term-entry-action"`
 
 	// ForceNewCluster starts a new cluster even if previously started; unsafe.
 	ForceNewCluster bool `json:"force-new-cluster"`
@@ -441,9 +481,12 @@ func NewConfig() *Config {
 		SnapshotCount:          etcdserver.DefaultSnapshotCount,
 		SnapshotCatchUpEntries: etcdserver.DefaultSnapshotCatchUpEntries,
 
-		MaxTxnOps:                        DefaultMaxTxnOps,
-		MaxRequestBytes:                  DefaultMaxRequestBytes,
-		ExperimentalWarningApplyDuration: DefaultWarningApplyDuration,
+		MaxTxnOps:                            DefaultMaxTxnOps,
+		MaxRequestBytes:                      DefaultMaxRequestBytes,
+		ExperimentalWarningApplyDuration:     DefaultWarningApplyDuration,
+		ExperimentalResultCacheTTL:           DefaultResultCacheTTL,
+		ExperimentalNospaceAlarmLowWatermark: DefaultNospaceAlarmWatermark,
+		ExperimentalStaleTermEntryAction:     etcdserver.StaleTermEntryProcess,
 
 		GRPCKeepAliveMinTime:  DefaultGRPCKeepAliveMinTime,
 		GRPCKeepAliveInterval: DefaultGRPCKeepAliveInterval,
@@ -676,6 +719,25 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("unknown auto-compaction-mode %q", cfg.AutoCompactionMode)
 	}
 
+	switch cfg.ExperimentalBackendAccessPattern {
+	case "":
+	case backend.AccessPatternSequential, backend.AccessPatternRandom:
+	default:
+		return fmt.Errorf("unknown experimental-backend-access-pattern %q", cfg.ExperimentalBackendAccessPattern)
+	}
+
+	if cfg.ExperimentalNospaceAlarmCheckInterval > 0 &&
+		(cfg.ExperimentalNospaceAlarmLowWatermark <= 0 || cfg.ExperimentalNospaceAlarmLowWatermark >= 1) {
+		return fmt.Errorf("experimental-nospace-alarm-low-watermark %v must be between 0 and 1", cfg.ExperimentalNospaceAlarmLowWatermark)
+	}
+
+	switch cfg.ExperimentalStaleTermEntryAction {
+	case "":
+	case etcdserver.StaleTermEntryProcess, etcdserver.StaleTermEntrySkip:
+	default:
+		return fmt.Errorf("unknown experimental-stale-term-entry-action %q", cfg.ExperimentalStaleTermEntryAction)
+	}
+
 	return nil
 }
 
@@ -175,6 +175,48 @@ func TestAutoCompactionModeInvalid(t *testing.T) {
 	}
 }
 
+func TestBackendAccessPatternInvalid(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Logger = "zap"
+	cfg.LogOutputs = []string{"/dev/null"}
+	cfg.ExperimentalBackendAccessPattern = "randomly"
+	err := cfg.Validate()
+	if err == nil {
+		t.Errorf("expected non-nil error, got %v", err)
+	}
+}
+
+func TestStaleTermEntryActionInvalid(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Logger = "zap"
+	cfg.LogOutputs = []string{"/dev/null"}
+	cfg.ExperimentalStaleTermEntryAction = "drop"
+	err := cfg.Validate()
+	if err == nil {
+		t.Errorf("expected non-nil error, got %v", err)
+	}
+}
+
+func TestNospaceAlarmLowWatermarkInvalid(t *testing.T) {
+	for _, w := range []float64{0, -0.5, 1, 1.5} {
+		cfg := NewConfig()
+		cfg.Logger = "zap"
+		cfg.LogOutputs = []string{"/dev/null"}
+		cfg.ExperimentalNospaceAlarmCheckInterval = time.Minute
+		cfg.ExperimentalNospaceAlarmLowWatermark = w
+		err := cfg.Validate()
+		if err == nil {
+			t.Errorf("watermark %v: expected non-nil error, got %v", w, err)
+		}
+
+		// the watermark is unused while the check is disabled
+		cfg.ExperimentalNospaceAlarmCheckInterval = 0
+		if err = cfg.Validate(); err != nil {
+			t.Errorf("watermark %v with check disabled: expected nil error, got %v", w, err)
+		}
+	}
+}
+
 func TestAutoCompactionModeParse(t *testing.T) {
 	tests := []struct {
 		mode      string
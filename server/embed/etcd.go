@@ -37,6 +37,7 @@ import (
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2http"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2v3"
@@ -88,6 +89,10 @@ type Etcd struct {
 	stopc chan struct{}
 	errc  chan error
 
+	// awaitingServe is true while a server started with
+	// Config.ManualBootstrap is not served yet.
+	awaitingServe bool
+
 	closeOnce sync.Once
 }
 
@@ -188,6 +193,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		DiscoveryProxy:                           cfg.Dproxy,
 		NewCluster:                               cfg.IsNewCluster(),
 		PeerTLSInfo:                              cfg.PeerTLSInfo,
+		ManualBootstrap:                          cfg.ManualBootstrap,
 		TickMs:                                   cfg.TickMs,
 		ElectionTicks:                            cfg.ElectionTicks(),
 		InitialElectionTickAdvance:               cfg.InitialElectionTickAdvance,
@@ -223,7 +229,18 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		ExperimentalMemoryMlock:                  cfg.ExperimentalMemoryMlock,
 		ExperimentalTxnModeWriteWithSharedBuffer: cfg.ExperimentalTxnModeWriteWithSharedBuffer,
 		ExperimentalBootstrapDefragThresholdMegabytes: cfg.ExperimentalBootstrapDefragThresholdMegabytes,
-		V2Deprecation: cfg.V2DeprecationEffective(),
+		MaxConcurrentWatchStreams:                     cfg.ExperimentalMaxConcurrentWatchStreams,
+		WALFsyncAlarmThreshold:                        cfg.ExperimentalWALFsyncAlarmThreshold,
+		WALFsyncAlarmTransferLeadership:               cfg.ExperimentalWALFsyncAlarmTransferLeadership,
+		AuditProposals:                                cfg.ExperimentalAuditProposals,
+		AuditProposalPayloads:                         cfg.ExperimentalAuditProposalPayloads,
+		ResultCacheSize:                               cfg.ExperimentalResultCacheSize,
+		ResultCacheTTL:                                cfg.ExperimentalResultCacheTTL,
+		BackendAccessPattern:                          cfg.ExperimentalBackendAccessPattern,
+		NospaceAlarmCheckInterval:                     cfg.ExperimentalNospaceAlarmCheckInterval,
+		NospaceAlarmLowWatermark:                      cfg.ExperimentalNospaceAlarmLowWatermark,
+		StaleTermEntryAction:                          cfg.ExperimentalStaleTermEntryAction,
+		V2Deprecation:                                 cfg.V2DeprecationEffective(),
 	}
 
 	if srvcfg.ExperimentalEnableDistributedTracing {
@@ -261,16 +278,52 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 			return e, err
 		}
 	}
+	// a server awaiting bootstrap is started and served by BootstrapSingleNode
+	if e.Server.AwaitingBootstrap() {
+		e.cfg.logger.Info(
+			"awaiting single-node bootstrap",
+			zap.String("local-member-id", e.Server.ID().String()),
+		)
+		e.awaitingServe = true
+		return e, nil
+	}
 	e.Server.Start()
 
-	if err = e.servePeers(); err != nil {
+	if err = e.serve(); err != nil {
 		return e, err
 	}
+	serving = true
+	return e, nil
+}
+
+// BootstrapSingleNode bootstraps the server of an Etcd started with
+// Config.ManualBootstrap as a single-node cluster of the given member,
+// as EtcdServer.BootstrapSingleNode does, and serves peer, client and
+// metrics traffic once the server is started. It must not be called
+// concurrently with Close.
+func (e *Etcd) BootstrapSingleNode(ctx context.Context, m membership.Member) error {
+	err := e.Server.BootstrapSingleNode(ctx, m)
+	if err == etcdserver.ErrDataDirNotEmpty || e.Server.AwaitingBootstrap() {
+		// the server was not started by this call
+		return err
+	}
+	if serr := e.serve(); serr != nil {
+		return serr
+	}
+	e.awaitingServe = false
+	return err
+}
+
+// serve serves peer, client and metrics traffic of a started server.
+func (e *Etcd) serve() (err error) {
+	if err = e.servePeers(); err != nil {
+		return err
+	}
 	if err = e.serveClients(); err != nil {
-		return e, err
+		return err
 	}
 	if err = e.serveMetrics(); err != nil {
-		return e, err
+		return err
 	}
 
 	e.cfg.logger.Info(
@@ -282,8 +335,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		zap.Strings("listen-client-urls", e.cfg.getLCURLs()),
 		zap.Strings("listen-metrics-urls", e.cfg.getMetricsURLs()),
 	)
-	serving = true
-	return e, nil
+	return nil
 }
 
 func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized bool) {
@@ -384,6 +436,13 @@ func (e *Etcd) Close() {
 	if e.Server != nil {
 		timeout = e.Server.Cfg.ReqTimeout()
 	}
+	if e.awaitingServe {
+		// no gRPC server was started for serveCtx.serversC
+		for _, sctx := range e.sctxs {
+			close(sctx.serversC)
+		}
+		e.awaitingServe = false
+	}
 	for _, sctx := range e.sctxs {
 		for ss := range sctx.serversC {
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -413,7 +472,12 @@ func (e *Etcd) Close() {
 
 	// close rafthttp transports
 	if e.Server != nil {
-		e.Server.Stop()
+		if e.Server.AwaitingBootstrap() {
+			// the server was never started
+			e.Server.Cleanup()
+		} else {
+			e.Server.Stop()
+		}
 	}
 
 	// close all idle connections in peer handler (wait up to 1-second)
@@ -0,0 +1,79 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
+)
+
+func TestStartEtcdManualBootstrap(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.ManualBootstrap = true
+	e, err := StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	if !e.Server.AwaitingBootstrap() {
+		t.Fatalf("AwaitingBootstrap = false, want true")
+	}
+	select {
+	case <-e.Server.ReadyNotify():
+		t.Fatalf("server is ready before bootstrap")
+	default:
+	}
+
+	m := membership.NewMember(cfg.Name, types.URLs(cfg.APUrls), cfg.InitialClusterToken, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err = e.BootstrapSingleNode(ctx, *m); err != nil {
+		t.Fatal(err)
+	}
+
+	client := v3client.New(e.Server)
+	defer client.Close()
+	if _, err = client.Put(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStartEtcdManualBootstrapClose(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.ManualBootstrap = true
+	e, err := StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	donec := make(chan struct{})
+	go func() {
+		e.Close()
+		close(donec)
+	}()
+	select {
+	case <-donec:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("closing a server awaiting bootstrap timed out")
+	}
+}
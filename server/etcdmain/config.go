@@ -288,6 +288,17 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.ec.ExperimentalMemoryMlock, "experimental-memory-mlock", cfg.ec.ExperimentalMemoryMlock, "Enable to enforce etcd pages (in particular bbolt) to stay in RAM.")
 	fs.BoolVar(&cfg.ec.ExperimentalTxnModeWriteWithSharedBuffer, "experimental-txn-mode-write-with-shared-buffer", true, "Enable the write transaction to use a shared buffer in its readonly check operations.")
 	fs.UintVar(&cfg.ec.ExperimentalBootstrapDefragThresholdMegabytes, "experimental-bootstrap-defrag-threshold-megabytes", 0, "Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.")
+	fs.UintVar(&cfg.ec.ExperimentalMaxConcurrentWatchStreams, "experimental-max-concurrent-watch-streams", 0, "Maximum number of concurrent watch streams the server accepts. 0 means unlimited.")
+	fs.DurationVar(&cfg.ec.ExperimentalWALFsyncAlarmThreshold, "experimental-wal-fsync-alarm-threshold", 0, "Raise an alarm if the average WAL fsync duration stays above this threshold. Needs to be set to non-zero value to take effect.")
+	fs.BoolVar(&cfg.ec.ExperimentalWALFsyncAlarmTransferLeadership, "experimental-wal-fsync-alarm-transfer-leadership", false, "Transfer leadership away from the local member when the WAL fsync alarm is raised.")
+	fs.BoolVar(&cfg.ec.ExperimentalAuditProposals, "experimental-audit-proposals", false, "Log every proposal with its request id, kind and user before it enters raft.")
+	fs.BoolVar(&cfg.ec.ExperimentalAuditProposalPayloads, "experimental-audit-proposal-payloads", false, "Include values in audited proposals. Values are redacted by default.")
+	fs.UintVar(&cfg.ec.ExperimentalResultCacheSize, "experimental-result-cache-size", 0, "Maximum number of applied request results retained by request id. Needs to be set to non-zero value to take effect.")
+	fs.DurationVar(&cfg.ec.ExperimentalResultCacheTTL, "experimental-result-cache-ttl", cfg.ec.ExperimentalResultCacheTTL, "Duration applied request results are retained.")
+	fs.StringVar(&cfg.ec.ExperimentalBackendAccessPattern, "experimental-backend-access-pattern", "", "Expected access pattern of the backend mmap ('sequential' or 'random'). Empty keeps the default behavior.")
+	fs.DurationVar(&cfg.ec.ExperimentalNospaceAlarmCheckInterval, "experimental-nospace-alarm-check-interval", 0, "Duration of time between the leader's checks whether the NOSPACE alarm can be cleared. Needs to be set to non-zero value to take effect.")
+	fs.Float64Var(&cfg.ec.ExperimentalNospaceAlarmLowWatermark, "experimental-nospace-alarm-low-watermark", cfg.ec.ExperimentalNospaceAlarmLowWatermark, "Fraction of the backend quota the backend size must fall below before the NOSPACE alarm is cleared.")
+	fs.StringVar(&cfg.ec.ExperimentalStaleTermEntryAction, "experimental-stale-term-entry-action", cfg.ec.ExperimentalStaleTermEntryAction, "Action on a committed entry whose term predates the latest snapshot or membership change ('process' or 'skip').")
 
 	// unsafe
 	fs.BoolVar(&cfg.ec.UnsafeNoFsync, "unsafe-no-fsync", false, "Disables fsync, unsafe, will cause data loss.")
@@ -246,6 +246,28 @@ Experimental feature:
     Enable the write transaction to use a shared buffer in its readonly check operations.
   --experimental-bootstrap-defrag-threshold-megabytes
     Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.
+  --experimental-max-concurrent-watch-streams 0
+    Maximum number of concurrent watch streams the server accepts. 0 means unlimited.
+  --experimental-wal-fsync-alarm-threshold '0s'
+    Raise an alarm if the average WAL fsync duration stays above this threshold. Needs to be set to non-zero value to take effect.
+  --experimental-wal-fsync-alarm-transfer-leadership 'false'
+    Transfer leadership away from the local member when the WAL fsync alarm is raised.
+  --experimental-audit-proposals 'false'
+    Log every proposal with its request id, kind and user before it enters raft.
+  --experimental-audit-proposal-payloads 'false'
+    Include values in audited proposals. Values are redacted by default.
+  --experimental-result-cache-size 0
+    Maximum number of applied request results retained by request id. Needs to be set to non-zero value to take effect.
+  --experimental-result-cache-ttl '5m'
+    Duration applied request results are retained.
+  --experimental-backend-access-pattern ''
+    Expected access pattern of the backend mmap ('sequential' or 'random'). Empty keeps the default behavior.
+  --experimental-nospace-alarm-check-interval '0s'
+    Duration of time between the leader's checks whether the NOSPACE alarm can be cleared. Needs to be set to non-zero value to take effect.
+  --experimental-nospace-alarm-low-watermark 0.8
+    Fraction of the backend quota the backend size must fall below before the NOSPACE alarm is cleared.
+  --experimental-stale-term-entry-action 'process'
+    Action on a committed entry whose term predates the latest snapshot or membership change ('process' or 'skip').
 
 Unsafe feature:
   --force-new-cluster 'false'
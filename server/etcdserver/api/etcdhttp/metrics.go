@@ -148,6 +148,8 @@ func checkHealth(lg *zap.Logger, srv etcdserver.ServerV2, excludedAlarms AlarmSe
 				h.Reason = "ALARM NOSPACE"
 			case etcdserverpb.AlarmType_CORRUPT:
 				h.Reason = "ALARM CORRUPT"
+			case etcdserverpb.AlarmType_SLOW_WAL_FSYNC:
+				h.Reason = "ALARM SLOW_WAL_FSYNC"
 			default:
 				h.Reason = "ALARM UNKNOWN"
 			}
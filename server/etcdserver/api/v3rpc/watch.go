@@ -44,6 +44,16 @@ type watchServer struct {
 	sg        etcdserver.RaftStatusGetter
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
+	wl        WatchStreamLimiter
+}
+
+// WatchStreamLimiter bounds the number of concurrent watch streams.
+type WatchStreamLimiter interface {
+	// AcquireWatchStream registers a new watch stream, or returns an
+	// error if no more streams may be opened.
+	AcquireWatchStream() error
+	// ReleaseWatchStream unregisters a previously acquired watch stream.
+	ReleaseWatchStream()
 }
 
 // NewWatchServer returns a new watch server.
@@ -59,6 +69,7 @@ func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
 		sg:        s,
 		watchable: s.Watchable(),
 		ag:        s,
+		wl:        s,
 	}
 	if srv.lg == nil {
 		srv.lg = zap.NewNop()
@@ -150,6 +161,11 @@ type serverWatchStream struct {
 }
 
 func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
+	if err = ws.wl.AcquireWatchStream(); err != nil {
+		return togRPCError(err)
+	}
+	defer ws.wl.ReleaseWatchStream()
+
 	sws := serverWatchStream{
 		lg: ws.lg,
 
@@ -16,11 +16,21 @@ package v3rpc
 
 import (
 	"bytes"
+	"context"
 	"math"
 	"testing"
+	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/config"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/mvcc"
+	betesting "go.etcd.io/etcd/server/v3/mvcc/backend/testing"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
 )
 
 func TestSendFragment(t *testing.T) {
@@ -93,3 +103,70 @@ func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	}
 	return resp
 }
+
+// TestWatchStreamLimit ensures Watch rejects streams beyond
+// MaxConcurrentWatchStreams and accepts them again once a stream closes.
+func TestWatchStreamLimit(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := mvcc.New(lg, b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	srv := &etcdserver.EtcdServer{Cfg: config.ServerConfig{MaxConcurrentWatchStreams: 1}}
+	ws := &watchServer{lg: lg, sg: srv, watchable: s, wl: srv}
+
+	// open a stream that stays active until it is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() { errc <- ws.Watch(newBlockingWatchStream(ctx)) }()
+	waitWatchStreams(t, srv, 1)
+
+	err := ws.Watch(newBlockingWatchStream(context.Background()))
+	if err != rpctypes.ErrGRPCTooManyWatchStreams {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCTooManyWatchStreams)
+	}
+
+	cancel()
+	if err = <-errc; err != rpctypes.ErrGRPCWatchCanceled {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCWatchCanceled)
+	}
+	waitWatchStreams(t, srv, 0)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() { errc <- ws.Watch(newBlockingWatchStream(ctx)) }()
+	waitWatchStreams(t, srv, 1)
+	cancel()
+	if err = <-errc; err != rpctypes.ErrGRPCWatchCanceled {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCWatchCanceled)
+	}
+}
+
+func waitWatchStreams(t *testing.T, s *etcdserver.EtcdServer, n int64) {
+	for i := 0; i < 100; i++ {
+		if s.WatchStreams() == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("watch streams = %d, want %d", s.WatchStreams(), n)
+}
+
+// blockingWatchStream is a watch stream that receives no requests
+// and ends when its context is done.
+type blockingWatchStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func newBlockingWatchStream(ctx context.Context) *blockingWatchStream {
+	return &blockingWatchStream{ctx: ctx}
+}
+
+func (ws *blockingWatchStream) Context() context.Context     { return ws.ctx }
+func (ws *blockingWatchStream) Send(*pb.WatchResponse) error { return nil }
+
+func (ws *blockingWatchStream) Recv() (*pb.WatchRequest, error) {
+	<-ws.ctx.Done()
+	return nil, ws.ctx.Err()
+}
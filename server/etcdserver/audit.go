@@ -0,0 +1,158 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"fmt"
+	"strings"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+
+	"go.uber.org/zap"
+)
+
+// auditProposal logs a proposal right before it enters raft.
+// Values are redacted unless payload auditing is enabled.
+func (s *EtcdServer) auditProposal(r *pb.InternalRaftRequest) {
+	s.Logger().Info(
+		"audit proposal",
+		zap.String("local-member-id", s.ID().String()),
+		zap.Uint64("request-id", r.Header.ID),
+		zap.String("request-kind", internalRaftRequestKind(r)),
+		zap.String("user", r.Header.Username),
+		zap.Stringer("request", auditRequestStringer(r, s.Cfg.AuditProposalPayloads)),
+	)
+}
+
+// auditV2Proposal logs a v2 proposal right before it enters raft.
+// Values are redacted unless payload auditing is enabled.
+func (s *EtcdServer) auditV2Proposal(r *pb.Request) {
+	s.Logger().Info(
+		"audit proposal",
+		zap.String("local-member-id", s.ID().String()),
+		zap.Uint64("request-id", r.ID),
+		zap.String("request-kind", "v2_"+strings.ToLower(r.Method)),
+		zap.Stringer("request", auditV2RequestStringer(r, s.Cfg.AuditProposalPayloads)),
+	)
+}
+
+// auditConfChange logs a configuration change right before it enters raft.
+func (s *EtcdServer) auditConfChange(cc raftpb.ConfChange) {
+	s.Logger().Info(
+		"audit proposal",
+		zap.String("local-member-id", s.ID().String()),
+		zap.Uint64("request-id", cc.ID),
+		zap.String("request-kind", "conf_change"),
+		zap.String("raft-conf-change", cc.Type.String()),
+		zap.String("raft-conf-change-node-id", types.ID(cc.NodeID).String()),
+	)
+}
+
+// auditV2RequestStringer returns the stringer used to log the v2 request r.
+// Its value is only kept with payloads.
+func auditV2RequestStringer(r *pb.Request, payloads bool) fmt.Stringer {
+	if payloads || len(r.Val) == 0 {
+		return r
+	}
+	rr := *r
+	rr.Val = fmt.Sprintf("<value_size:%d>", len(r.Val))
+	return &rr
+}
+
+// auditRequestStringer returns the stringer used to log r. Passwords and
+// authentication tokens are always redacted; put and txn values are only
+// kept with payloads.
+func auditRequestStringer(r *pb.InternalRaftRequest, payloads bool) fmt.Stringer {
+	if r.Authenticate != nil {
+		// InternalRaftStringer keeps the simple token, which is a live
+		// credential for as long as the token is valid.
+		rr := *r
+		rr.Authenticate = &pb.InternalAuthenticateRequest{Name: r.Authenticate.Name}
+		return &rr
+	}
+	if payloads && (r.Put != nil || r.Txn != nil) {
+		return r
+	}
+	return &pb.InternalRaftStringer{Request: r}
+}
+
+// internalRaftRequestKind returns the name of the request carried by r.
+func internalRaftRequestKind(r *pb.InternalRaftRequest) string {
+	switch {
+	case r.V2 != nil:
+		return "v2"
+	case r.Range != nil:
+		return "range"
+	case r.Put != nil:
+		return "put"
+	case r.DeleteRange != nil:
+		return "delete_range"
+	case r.Txn != nil:
+		return "txn"
+	case r.Compaction != nil:
+		return "compaction"
+	case r.LeaseGrant != nil:
+		return "lease_grant"
+	case r.LeaseRevoke != nil:
+		return "lease_revoke"
+	case r.LeaseCheckpoint != nil:
+		return "lease_checkpoint"
+	case r.Alarm != nil:
+		return "alarm"
+	case r.AuthEnable != nil:
+		return "auth_enable"
+	case r.AuthDisable != nil:
+		return "auth_disable"
+	case r.AuthStatus != nil:
+		return "auth_status"
+	case r.Authenticate != nil:
+		return "authenticate"
+	case r.AuthUserAdd != nil:
+		return "auth_user_add"
+	case r.AuthUserDelete != nil:
+		return "auth_user_delete"
+	case r.AuthUserGet != nil:
+		return "auth_user_get"
+	case r.AuthUserChangePassword != nil:
+		return "auth_user_change_password"
+	case r.AuthUserGrantRole != nil:
+		return "auth_user_grant_role"
+	case r.AuthUserRevokeRole != nil:
+		return "auth_user_revoke_role"
+	case r.AuthUserList != nil:
+		return "auth_user_list"
+	case r.AuthRoleList != nil:
+		return "auth_role_list"
+	case r.AuthRoleAdd != nil:
+		return "auth_role_add"
+	case r.AuthRoleDelete != nil:
+		return "auth_role_delete"
+	case r.AuthRoleGet != nil:
+		return "auth_role_get"
+	case r.AuthRoleGrantPermission != nil:
+		return "auth_role_grant_permission"
+	case r.AuthRoleRevokePermission != nil:
+		return "auth_role_revoke_permission"
+	case r.ClusterVersionSet != nil:
+		return "cluster_version_set"
+	case r.ClusterMemberAttrSet != nil:
+		return "cluster_member_attr_set"
+	case r.DowngradeInfoSet != nil:
+		return "downgrade_info_set"
+	}
+	return "unknown"
+}
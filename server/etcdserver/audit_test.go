@@ -0,0 +1,220 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/idutil"
+	"go.etcd.io/etcd/pkg/v3/wait"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/config"
+	betesting "go.etcd.io/etcd/server/v3/mvcc/backend/testing"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestAuditProposals(t *testing.T) {
+	tests := []struct {
+		name     string
+		audit    bool
+		payloads bool
+
+		wlogged bool
+		wvalue  bool
+	}{
+		{name: "disabled"},
+		{name: "audit", audit: true, wlogged: true},
+		{name: "audit with payloads", audit: true, payloads: true, wlogged: true, wvalue: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zap.InfoLevel)
+			lg := zap.New(core)
+			be, _ := betesting.NewDefaultTmpBackend(t)
+			defer betesting.Close(t, be)
+
+			n := newNodeRecorder()
+			ch := make(chan interface{}, 1)
+			// simulate that request has gone through consensus
+			ch <- &applyResult{resp: &pb.PutResponse{}}
+			srv := &EtcdServer{
+				lgMu: new(sync.RWMutex),
+				lg:   lg,
+				Cfg: config.ServerConfig{
+					Logger:                lg,
+					TickMs:                1,
+					MaxRequestBytes:       1000,
+					AuditProposals:        tt.audit,
+					AuditProposalPayloads: tt.payloads,
+				},
+				id:        1,
+				r:         *newRaftNode(raftNodeConfig{lg: lg, Node: n}),
+				w:         wait.NewWithResponse(ch),
+				reqIDGen:  idutil.NewGenerator(0, time.Time{}),
+				authStore: auth.NewAuthStore(lg, be, nil, 0),
+				be:        be,
+			}
+
+			_, err := srv.Put(context.Background(), &pb.PutRequest{Key: []byte("foo"), Value: []byte("secret-value")})
+			if err != nil {
+				t.Fatalf("put error: %v", err)
+			}
+
+			action := n.Action()
+			if len(action) != 1 || action[0].Name != "Propose" {
+				t.Fatalf("action = %v, want [Propose]", action)
+			}
+			var r pb.InternalRaftRequest
+			if err = r.Unmarshal(action[0].Params[0].([]byte)); err != nil {
+				t.Fatalf("unmarshal request error: %v", err)
+			}
+
+			entries := logs.FilterMessage("audit proposal").All()
+			if !tt.wlogged {
+				if len(entries) != 0 {
+					t.Fatalf("len(audit logs) = %d, want 0", len(entries))
+				}
+				return
+			}
+			if len(entries) != 1 {
+				t.Fatalf("len(audit logs) = %d, want 1", len(entries))
+			}
+			fields := entries[0].ContextMap()
+			if id := fields["request-id"]; id != r.Header.ID {
+				t.Errorf("request-id = %v, want %d", id, r.Header.ID)
+			}
+			if kind := fields["request-kind"]; kind != "put" {
+				t.Errorf("request-kind = %v, want put", kind)
+			}
+			req, _ := fields["request"].(string)
+			if !strings.Contains(req, "foo") {
+				t.Errorf("request = %q, want it to contain the key", req)
+			}
+			if got := strings.Contains(req, "secret-value"); got != tt.wvalue {
+				t.Errorf("request = %q, value logged = %v, want %v", req, got, tt.wvalue)
+			}
+		})
+	}
+}
+
+func TestAuditV2Proposals(t *testing.T) {
+	for _, payloads := range []bool{false, true} {
+		core, logs := observer.New(zap.InfoLevel)
+		lg := zap.New(core)
+		n := newNodeRecorder()
+		ch := make(chan interface{}, 1)
+		// simulate that request has gone through consensus
+		ch <- Response{}
+		srv := &EtcdServer{
+			lgMu:     new(sync.RWMutex),
+			lg:       lg,
+			Cfg:      config.ServerConfig{Logger: lg, TickMs: 1, AuditProposals: true, AuditProposalPayloads: payloads},
+			id:       1,
+			r:        *newRaftNode(raftNodeConfig{lg: lg, Node: n}),
+			w:        wait.NewWithResponse(ch),
+			reqIDGen: idutil.NewGenerator(0, time.Time{}),
+		}
+
+		if _, err := srv.Do(context.Background(), pb.Request{Method: "PUT", Path: "/foo", Val: "secret-value"}); err != nil {
+			t.Fatalf("payloads %v: do error: %v", payloads, err)
+		}
+
+		entries := logs.FilterMessage("audit proposal").All()
+		if len(entries) != 1 {
+			t.Fatalf("payloads %v: len(audit logs) = %d, want 1", payloads, len(entries))
+		}
+		fields := entries[0].ContextMap()
+		if kind := fields["request-kind"]; kind != "v2_put" {
+			t.Errorf("payloads %v: request-kind = %v, want v2_put", payloads, kind)
+		}
+		req, _ := fields["request"].(string)
+		if !strings.Contains(req, "/foo") {
+			t.Errorf("payloads %v: request = %q, want it to contain the path", payloads, req)
+		}
+		if got := strings.Contains(req, "secret-value"); got != payloads {
+			t.Errorf("payloads %v: request = %q, value logged = %v, want %v", payloads, req, got, payloads)
+		}
+	}
+}
+
+func TestAuditConfChange(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	lg := zap.New(core)
+	n := newNodeRecorder()
+	ch := make(chan interface{}, 1)
+	// simulate that request has gone through consensus
+	ch <- &confChangeResponse{}
+	srv := &EtcdServer{
+		lgMu:     new(sync.RWMutex),
+		lg:       lg,
+		Cfg:      config.ServerConfig{Logger: lg, AuditProposals: true},
+		id:       1,
+		r:        *newRaftNode(raftNodeConfig{lg: lg, Node: n}),
+		w:        wait.NewWithResponse(ch),
+		reqIDGen: idutil.NewGenerator(0, time.Time{}),
+	}
+
+	if _, err := srv.configure(context.Background(), raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 2}); err != nil {
+		t.Fatalf("configure error: %v", err)
+	}
+
+	entries := logs.FilterMessage("audit proposal").All()
+	if len(entries) != 1 {
+		t.Fatalf("len(audit logs) = %d, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if kind := fields["request-kind"]; kind != "conf_change" {
+		t.Errorf("request-kind = %v, want conf_change", kind)
+	}
+	if typ := fields["raft-conf-change"]; typ != raftpb.ConfChangeAddNode.String() {
+		t.Errorf("raft-conf-change = %v, want %s", typ, raftpb.ConfChangeAddNode)
+	}
+}
+
+func TestAuditRequestStringerRedactsPasswords(t *testing.T) {
+	r := &pb.InternalRaftRequest{
+		Header:      &pb.RequestHeader{ID: 1},
+		AuthUserAdd: &pb.AuthUserAddRequest{Name: "user", Password: "secret-password"},
+	}
+	if s := auditRequestStringer(r, true).String(); strings.Contains(s, "secret-password") {
+		t.Errorf("request = %q, want password redacted", s)
+	}
+}
+
+func TestAuditRequestStringerRedactsTokens(t *testing.T) {
+	r := &pb.InternalRaftRequest{
+		Header:       &pb.RequestHeader{ID: 1},
+		Authenticate: &pb.InternalAuthenticateRequest{Name: "user", Password: "secret-password", SimpleToken: "secret-token"},
+	}
+	for _, payloads := range []bool{false, true} {
+		s := auditRequestStringer(r, payloads).String()
+		if strings.Contains(s, "secret-token") || strings.Contains(s, "secret-password") {
+			t.Errorf("payloads %v: request = %q, want token and password redacted", payloads, s)
+		}
+		if !strings.Contains(s, "user") {
+			t.Errorf("payloads %v: request = %q, want user name", payloads, s)
+		}
+	}
+	if r.Authenticate.SimpleToken != "secret-token" {
+		t.Errorf("simple token = %q, want the proposed request unchanged", r.Authenticate.SimpleToken)
+	}
+}
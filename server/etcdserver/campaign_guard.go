@@ -0,0 +1,49 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import "sync"
+
+// campaignGuard holds the function that decides whether the local raft
+// node may campaign for leadership. It is consulted by the raft node, so
+// the guard function must not block.
+type campaignGuard struct {
+	mu sync.RWMutex
+	fn func() bool
+}
+
+func (g *campaignGuard) set(fn func() bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.fn = fn
+}
+
+// allow reports whether the local raft node may campaign. It allows
+// campaigns if no guard function is set.
+func (g *campaignGuard) allow() bool {
+	g.mu.RLock()
+	fn := g.fn
+	g.mu.RUnlock()
+	return fn == nil || fn()
+}
+
+// SetCampaignGuard sets fn to be called before the member campaigns for
+// leadership on election timeout. If fn returns false, the member does not
+// campaign; it keeps voting for other members and applying entries. An
+// explicit leadership transfer to the member is not vetoed. fn must not
+// block. A nil fn removes the guard.
+func (s *EtcdServer) SetCampaignGuard(fn func() bool) {
+	s.campaignGuard.set(fn)
+}
@@ -0,0 +1,238 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/mock/mockstorage"
+	"go.uber.org/zap"
+)
+
+func TestSetCampaignGuard(t *testing.T) {
+	srv := &EtcdServer{campaignGuard: &campaignGuard{}}
+	if !srv.campaignGuard.allow() {
+		t.Errorf("allow = false without a guard, want true")
+	}
+
+	allow := false
+	srv.SetCampaignGuard(func() bool { return allow })
+	if srv.campaignGuard.allow() {
+		t.Errorf("allow = true, want false")
+	}
+	allow = true
+	if !srv.campaignGuard.allow() {
+		t.Errorf("allow = false, want true")
+	}
+
+	srv.SetCampaignGuard(func() bool { return false })
+	srv.SetCampaignGuard(nil)
+	if !srv.campaignGuard.allow() {
+		t.Errorf("allow = false after removing the guard, want true")
+	}
+}
+
+// TestCampaignGuardVetoesCampaign ensures that the raft node does not
+// campaign while the server's campaign guard denies it.
+func TestCampaignGuardVetoesCampaign(t *testing.T) {
+	srv := &EtcdServer{campaignGuard: &campaignGuard{}}
+	srv.SetCampaignGuard(func() bool { return false })
+
+	ms := raft.NewMemoryStorage()
+	c := &raft.Config{
+		ID:              1,
+		ElectionTick:    10,
+		HeartbeatTick:   1,
+		Storage:         ms,
+		MaxSizePerMsg:   maxSizePerMsg,
+		MaxInflightMsgs: maxInflightMsgs,
+		CampaignGuard:   srv.campaignGuard.allow,
+	}
+	n := raft.StartNode(c, []raft.Peer{{ID: 1}})
+	defer n.Stop()
+
+	// apply the bootstrap configuration change; raft does not campaign
+	// while configuration changes are pending.
+	rd := <-n.Ready()
+	if err := ms.Append(rd.Entries); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range rd.CommittedEntries {
+		if e.Type != raftpb.EntryConfChange {
+			continue
+		}
+		var cc raftpb.ConfChange
+		pbutil.MustUnmarshal(&cc, e.Data)
+		n.ApplyConfChange(cc)
+	}
+	n.Advance()
+
+	ctx := context.TODO()
+	if err := n.Campaign(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if st := n.Status(); st.RaftState != raft.StateFollower {
+		t.Fatalf("state = %v, want %v", st.RaftState, raft.StateFollower)
+	}
+
+	srv.SetCampaignGuard(nil)
+	if err := n.Campaign(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if st := n.Status(); st.RaftState != raft.StateLeader {
+		t.Errorf("state = %v, want %v", st.RaftState, raft.StateLeader)
+	}
+}
+
+// TestCampaignGuardRaftLoop ensures that the raft loop of a member does not
+// campaign while its campaign guard denies it, and campaigns again once the
+// guard allows it.
+func TestCampaignGuardRaftLoop(t *testing.T) {
+	lg := zap.NewExample()
+	srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: lg, campaignGuard: &campaignGuard{}}
+	srv.SetCampaignGuard(func() bool { return false })
+
+	ms := raft.NewMemoryStorage()
+	c := &raft.Config{
+		ID:              1,
+		ElectionTick:    10,
+		HeartbeatTick:   1,
+		Storage:         ms,
+		MaxSizePerMsg:   maxSizePerMsg,
+		MaxInflightMsgs: maxInflightMsgs,
+		CampaignGuard:   srv.campaignGuard.allow,
+	}
+	n := newCampaignRecorder(raft.StartNode(c, []raft.Peer{{ID: 1}}))
+	srv.r = *newRaftNode(raftNodeConfig{
+		lg:          lg,
+		Node:        n,
+		storage:     mockstorage.NewStorageRecorder(""),
+		raftStorage: ms,
+		transport:   newNopTransporter(),
+	})
+	srv.r.start(&raftReadyHandler{
+		getLead:              func() uint64 { return 0 },
+		updateLead:           func(uint64) {},
+		updateLeadership:     func(bool) {},
+		updateCommittedIndex: func(uint64) {},
+	})
+	defer srv.r.Stop()
+
+	// apply the bootstrap configuration change; raft does not campaign
+	// while configuration changes are pending.
+	go func() {
+		for {
+			select {
+			case ap := <-srv.r.applyc:
+				for _, e := range ap.entries {
+					if e.Type != raftpb.EntryConfChange {
+						continue
+					}
+					var cc raftpb.ConfChange
+					pbutil.MustUnmarshal(&cc, e.Data)
+					n.ApplyConfChange(cc)
+				}
+				select {
+				case <-ap.notifyc:
+				case <-srv.r.done:
+					return
+				}
+			case <-srv.r.done:
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	// several randomized election timeouts elapse
+	for i := 0; i < 5*c.ElectionTick; {
+		select {
+		case a := <-n.Chan():
+			t.Fatalf("unexpected action %v while the guard denies campaigns", a)
+		case <-ticker.C:
+			srv.r.tick()
+			i++
+		}
+	}
+
+	srv.SetCampaignGuard(nil)
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case a := <-n.Chan():
+			if a.Name != "Campaign" {
+				t.Fatalf("action = %v, want Campaign", a)
+			}
+			return
+		case <-ticker.C:
+			srv.r.tick()
+		case <-timeout:
+			t.Fatalf("no campaign after the guard allows it")
+		}
+	}
+}
+
+// campaignRecorder is a raft node that records a "Campaign" action whenever
+// the node leaves the follower state.
+type campaignRecorder struct {
+	raft.Node
+	testutil.Recorder
+
+	readyc chan raft.Ready
+	stopc  chan struct{}
+}
+
+func newCampaignRecorder(n raft.Node) *campaignRecorder {
+	r := &campaignRecorder{
+		Node:     n,
+		Recorder: testutil.NewRecorderStream(),
+		readyc:   make(chan raft.Ready),
+		stopc:    make(chan struct{}),
+	}
+	go func() {
+		for {
+			select {
+			case rd := <-n.Ready():
+				if rd.SoftState != nil && rd.RaftState != raft.StateFollower {
+					r.Record(testutil.Action{Name: "Campaign"})
+				}
+				select {
+				case r.readyc <- rd:
+				case <-r.stopc:
+					return
+				}
+			case <-r.stopc:
+				return
+			}
+		}
+	}()
+	return r
+}
+
+func (r *campaignRecorder) Ready() <-chan raft.Ready { return r.readyc }
+
+func (r *campaignRecorder) Stop() {
+	close(r.stopc)
+	r.Node.Stop()
+}
@@ -44,6 +44,9 @@ var (
 	ErrInvalidDowngradeTargetVersion = errors.New("etcdserver: invalid downgrade target version")
 	ErrDowngradeInProcess            = errors.New("etcdserver: cluster has a downgrade job in progress")
 	ErrNoInflightDowngrade           = errors.New("etcdserver: no inflight downgrade job")
+	ErrTooManyWatchStreams           = errors.New("etcdserver: too many watch streams")
+	ErrDataDirNotEmpty               = errors.New("etcdserver: data dir is not empty")
+	ErrStaleTermEntry                = errors.New("etcdserver: entry term predates membership configuration")
 )
 
 type DiscoveryError struct {
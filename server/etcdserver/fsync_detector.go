@@ -0,0 +1,192 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// walFsyncWindow is the number of recent WAL fsync durations
+	// averaged by the detector.
+	walFsyncWindow = 16
+	// walFsyncSustain is the number of consecutive observations the moving
+	// average must stay above (or below) the threshold before fsyncs are
+	// reported slow (or recovered). It is larger than the window so that a
+	// single spike, which only stays in the window for walFsyncWindow
+	// observations, can never report fsyncs slow on its own.
+	walFsyncSustain = 2 * walFsyncWindow
+)
+
+// walFsyncDetector detects chronically slow WAL fsyncs. It keeps a moving
+// average over the last walFsyncWindow durations and reports fsyncs slow
+// once the average has exceeded the threshold for walFsyncSustain
+// consecutive observations.
+type walFsyncDetector struct {
+	lg        *zap.Logger
+	threshold time.Duration
+
+	// onSlow is called with the moving average when fsyncs become slow.
+	onSlow func(avg time.Duration)
+	// onRecovered is called with the moving average when fsyncs recover.
+	onRecovered func(avg time.Duration)
+
+	mu      sync.Mutex
+	samples [walFsyncWindow]time.Duration
+	n       int
+	next    int
+	sum     time.Duration
+	// streak counts consecutive observations on the opposite side of
+	// the threshold from the current state.
+	streak int
+	slow   bool
+}
+
+func newWALFsyncDetector(lg *zap.Logger, threshold time.Duration, onSlow, onRecovered func(avg time.Duration)) *walFsyncDetector {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	return &walFsyncDetector{
+		lg:          lg,
+		threshold:   threshold,
+		onSlow:      onSlow,
+		onRecovered: onRecovered,
+	}
+}
+
+// Observe records the duration of a WAL fsync.
+func (d *walFsyncDetector) Observe(took time.Duration) {
+	d.mu.Lock()
+	if d.n == walFsyncWindow {
+		d.sum -= d.samples[d.next]
+	} else {
+		d.n++
+	}
+	d.samples[d.next] = took
+	d.sum += took
+	d.next = (d.next + 1) % walFsyncWindow
+
+	avg := d.sum / time.Duration(d.n)
+	if d.n < walFsyncWindow || (avg > d.threshold) == d.slow {
+		d.streak = 0
+		d.mu.Unlock()
+		return
+	}
+	d.streak++
+	if d.streak < walFsyncSustain {
+		d.mu.Unlock()
+		return
+	}
+	d.streak = 0
+	d.slow = !d.slow
+	slow := d.slow
+	d.mu.Unlock()
+
+	if slow {
+		d.lg.Warn(
+			"WAL fsync latency exceeded threshold",
+			zap.Duration("average-fsync-duration", avg),
+			zap.Duration("threshold", d.threshold),
+		)
+		if d.onSlow != nil {
+			d.onSlow(avg)
+		}
+		return
+	}
+	d.lg.Info(
+		"WAL fsync latency recovered",
+		zap.Duration("average-fsync-duration", avg),
+		zap.Duration("threshold", d.threshold),
+	)
+	if d.onRecovered != nil {
+		d.onRecovered(avg)
+	}
+}
+
+// Slow returns true if WAL fsyncs are currently considered slow.
+func (d *walFsyncDetector) Slow() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.slow
+}
+
+// setSlow sets whether WAL fsyncs are considered slow without
+// reporting the change.
+func (d *walFsyncDetector) setSlow(slow bool) {
+	d.mu.Lock()
+	d.slow = slow
+	d.streak = 0
+	d.mu.Unlock()
+}
+
+func (s *EtcdServer) onSlowWALFsync(avg time.Duration) {
+	slowWALFsync.Set(1)
+	// raise the alarm and transfer leadership off the raft loop; both
+	// wait for the very loop that called us to make progress.
+	s.GoAttach(func() {
+		s.updateWALFsyncAlarm()
+		if !s.Cfg.WALFsyncAlarmTransferLeadership {
+			return
+		}
+		lg := s.Logger()
+		lg.Warn(
+			"transferring leadership away due to slow WAL fsync",
+			zap.String("local-member-id", s.ID().String()),
+			zap.Duration("average-fsync-duration", avg),
+		)
+		if err := s.TransferLeadership(); err != nil {
+			lg.Warn("leadership transfer failed", zap.String("local-member-id", s.ID().String()), zap.Error(err))
+		}
+	})
+}
+
+func (s *EtcdServer) onWALFsyncRecovered(time.Duration) {
+	slowWALFsync.Set(0)
+	s.GoAttach(s.updateWALFsyncAlarm)
+}
+
+// updateWALFsyncAlarm raises or clears the SLOW_WAL_FSYNC alarm of the local
+// member through raft, following the current state of the detector rather
+// than the change that triggered the update so that racing updates settle
+// on the latest state.
+func (s *EtcdServer) updateWALFsyncAlarm() {
+	s.walFsyncAlarmMu.Lock()
+	defer s.walFsyncAlarmMu.Unlock()
+
+	action := pb.AlarmRequest_DEACTIVATE
+	if s.r.fsyncDetector.Slow() {
+		action = pb.AlarmRequest_ACTIVATE
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	_, err := s.Alarm(ctx, &pb.AlarmRequest{
+		Action:   action,
+		MemberID: uint64(s.ID()),
+		Alarm:    pb.AlarmType_SLOW_WAL_FSYNC,
+	})
+	cancel()
+	if err != nil {
+		s.Logger().Warn(
+			"failed to update SLOW_WAL_FSYNC alarm",
+			zap.String("action", action.String()),
+			zap.Error(err),
+		)
+	}
+}
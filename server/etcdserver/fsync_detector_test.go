@@ -0,0 +1,287 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/idutil"
+	"go.etcd.io/etcd/pkg/v3/wait"
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/config"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	betesting "go.etcd.io/etcd/server/v3/mvcc/backend/testing"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestWALFsyncDetectorSustainedSlowFsync(t *testing.T) {
+	var slows, recoveries int
+	d := newWALFsyncDetector(zaptest.NewLogger(t), 10*time.Millisecond,
+		func(time.Duration) { slows++ },
+		func(time.Duration) { recoveries++ },
+	)
+
+	// the average is first computed once the window is full
+	for i := 0; i < walFsyncWindow+walFsyncSustain-2; i++ {
+		d.Observe(50 * time.Millisecond)
+	}
+	if d.Slow() || slows != 0 {
+		t.Fatalf("reported slow before the slow fsyncs were sustained")
+	}
+	d.Observe(50 * time.Millisecond)
+	if !d.Slow() || slows != 1 {
+		t.Fatalf("slow = %v (slows %d), want true (slows 1)", d.Slow(), slows)
+	}
+
+	// staying slow must not report slow fsyncs again
+	for i := 0; i < 10*walFsyncSustain; i++ {
+		d.Observe(50 * time.Millisecond)
+	}
+	if slows != 1 {
+		t.Fatalf("slows = %d, want 1", slows)
+	}
+
+	for i := 0; i < walFsyncWindow+walFsyncSustain; i++ {
+		d.Observe(time.Millisecond)
+	}
+	if d.Slow() || recoveries != 1 {
+		t.Fatalf("slow = %v (recoveries %d), want false (recoveries 1)", d.Slow(), recoveries)
+	}
+}
+
+func TestWALFsyncDetectorTransientSpikes(t *testing.T) {
+	slows := 0
+	d := newWALFsyncDetector(zaptest.NewLogger(t), 10*time.Millisecond, func(time.Duration) { slows++ }, nil)
+
+	for i := 0; i < 20*walFsyncSustain; i++ {
+		took := time.Millisecond
+		// a single huge spike lifts the average above the threshold,
+		// but only for as long as it stays in the window.
+		if i%(walFsyncSustain+walFsyncWindow) == 0 {
+			took = 10 * time.Second
+		}
+		d.Observe(took)
+	}
+	if d.Slow() || slows != 0 {
+		t.Fatalf("slow = %v (slows %d), want false (slows 0)", d.Slow(), slows)
+	}
+}
+
+// TestWALFsyncAlarm ensures sustained slow WAL fsyncs raise the
+// SLOW_WAL_FSYNC alarm of the local member through raft and that the
+// alarm is cleared once fsyncs recover.
+func TestWALFsyncAlarm(t *testing.T) {
+	n := newNodeRecorder()
+	srv := newWALFsyncAlarmTestServer(t, n, false)
+	d := srv.r.fsyncDetector
+
+	for i := 0; i < walFsyncWindow+walFsyncSustain; i++ {
+		d.Observe(time.Second)
+	}
+	srv.wg.Wait()
+	applyWALFsyncAlarm(t, srv, n.Action(), pb.AlarmRequest_ACTIVATE)
+	if alarms := srv.alarmStore.Get(pb.AlarmType_SLOW_WAL_FSYNC); len(alarms) != 1 || alarms[0].MemberID != 1 {
+		t.Fatalf("SLOW_WAL_FSYNC alarms = %v, want raised by member 1", alarms)
+	}
+
+	for i := 0; i < walFsyncWindow+walFsyncSustain; i++ {
+		d.Observe(time.Millisecond)
+	}
+	srv.wg.Wait()
+	applyWALFsyncAlarm(t, srv, n.Action(), pb.AlarmRequest_DEACTIVATE)
+	if alarms := srv.alarmStore.Get(pb.AlarmType_SLOW_WAL_FSYNC); len(alarms) != 0 {
+		t.Fatalf("SLOW_WAL_FSYNC alarms = %v, want cleared", alarms)
+	}
+}
+
+// TestWALFsyncAlarmTransientSpikes ensures isolated fsync spikes do not
+// raise the SLOW_WAL_FSYNC alarm.
+func TestWALFsyncAlarmTransientSpikes(t *testing.T) {
+	n := newNodeRecorder()
+	srv := newWALFsyncAlarmTestServer(t, n, false)
+
+	for i := 0; i < 20*walFsyncSustain; i++ {
+		took := time.Millisecond
+		if i%(walFsyncSustain+walFsyncWindow) == 0 {
+			took = 10 * time.Second
+		}
+		srv.r.fsyncDetector.Observe(took)
+	}
+	srv.wg.Wait()
+	if g := n.Action(); len(g) != 0 {
+		t.Fatalf("action = %v, want no action", g)
+	}
+}
+
+// TestWALFsyncAlarmRestore ensures a member restarted with its SLOW_WAL_FSYNC
+// alarm raised clears the alarm once its fsyncs are fast.
+func TestWALFsyncAlarmRestore(t *testing.T) {
+	n := newNodeRecorder()
+	srv := newWALFsyncAlarmTestServer(t, n, false)
+	srv.alarmStore.Activate(1, pb.AlarmType_SLOW_WAL_FSYNC)
+
+	if err := srv.restoreAlarms(); err != nil {
+		t.Fatal(err)
+	}
+	if !srv.r.fsyncDetector.Slow() {
+		t.Fatalf("slow = false after restoring the SLOW_WAL_FSYNC alarm, want true")
+	}
+	for i := 0; i < walFsyncWindow+walFsyncSustain; i++ {
+		srv.r.fsyncDetector.Observe(time.Millisecond)
+	}
+	srv.wg.Wait()
+	applyWALFsyncAlarm(t, srv, n.Action(), pb.AlarmRequest_DEACTIVATE)
+	if alarms := srv.alarmStore.Get(pb.AlarmType_SLOW_WAL_FSYNC); len(alarms) != 0 {
+		t.Fatalf("SLOW_WAL_FSYNC alarms = %v, want cleared", alarms)
+	}
+}
+
+func TestWALFsyncAlarmTransferLeadership(t *testing.T) {
+	tests := []struct {
+		transfer bool
+		wactions []string
+	}{
+		{false, []string{"Propose"}},
+		{true, []string{"Propose", "TransferLeadership"}},
+	}
+	for i, tt := range tests {
+		n := &nodeTransferLeadershipRecorder{nodeRecorder: newNodeRecorder()}
+		srv := newWALFsyncAlarmTestServer(t, n, tt.transfer)
+		srv.r.transport = &activeTransporter{active: 2}
+		srv.cluster = newTestCluster(t, []*membership.Member{
+			{ID: 1, RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"http://127.0.0.1:2380"}}},
+			{ID: 2, RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"http://127.0.0.1:2381"}}},
+		})
+		srv.setLead(1)
+		n.setLead = srv.setLead
+
+		for j := 0; j < walFsyncWindow+walFsyncSustain; j++ {
+			srv.r.fsyncDetector.Observe(time.Second)
+		}
+		srv.wg.Wait()
+
+		action := n.Action()
+		var names []string
+		for _, a := range action {
+			names = append(names, a.Name)
+		}
+		if !reflect.DeepEqual(names, tt.wactions) {
+			t.Fatalf("#%d: action = %v, want %v", i, names, tt.wactions)
+		}
+		applyWALFsyncAlarm(t, srv, action[:1], pb.AlarmRequest_ACTIVATE)
+		if tt.transfer {
+			want := testutil.Action{Name: "TransferLeadership", Params: []interface{}{uint64(1), uint64(2)}}
+			if !reflect.DeepEqual(action[1], want) {
+				t.Errorf("#%d: action = %v, want %v", i, action[1], want)
+			}
+		}
+	}
+}
+
+// newWALFsyncAlarmTestServer returns a server with member ID 1 whose WAL
+// fsync detector reports to it. Proposals to n are answered as applied.
+func newWALFsyncAlarmTestServer(t *testing.T, n raft.Node, transfer bool) *EtcdServer {
+	ch := make(chan interface{}, 2)
+	// simulate that the alarm requests have gone through consensus
+	ch <- &applyResult{resp: &pb.AlarmResponse{}}
+	ch <- &applyResult{resp: &pb.AlarmResponse{}}
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	t.Cleanup(func() { betesting.Close(t, be) })
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	srv := &EtcdServer{
+		lgMu: new(sync.RWMutex),
+		lg:   lg,
+		Cfg: config.ServerConfig{
+			Logger:                          lg,
+			TickMs:                          1,
+			ElectionTicks:                   10,
+			MaxRequestBytes:                 1000,
+			WALFsyncAlarmThreshold:          10 * time.Millisecond,
+			WALFsyncAlarmTransferLeadership: transfer,
+		},
+		id:        1,
+		r:         *newRaftNode(raftNodeConfig{lg: lg, Node: n}),
+		w:         wait.NewWithResponse(ch),
+		reqIDGen:  idutil.NewGenerator(0, time.Time{}),
+		authStore: auth.NewAuthStore(lg, be, nil, 0),
+		be:        be,
+		ctx:       ctx,
+		cancel:    cancel,
+		stopping:  make(chan struct{}),
+	}
+	srv.r.fsyncDetector = newWALFsyncDetector(lg, srv.Cfg.WALFsyncAlarmThreshold, srv.onSlowWALFsync, srv.onWALFsyncRecovered)
+	as, err := v3alarm.NewAlarmStore(lg, srv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.alarmStore = as
+	return srv
+}
+
+// applyWALFsyncAlarm checks that the last recorded action proposes the given
+// SLOW_WAL_FSYNC alarm action for member 1 and applies it.
+func applyWALFsyncAlarm(t *testing.T, srv *EtcdServer, action []testutil.Action, waction pb.AlarmRequest_AlarmAction) {
+	t.Helper()
+	if len(action) == 0 || action[len(action)-1].Name != "Propose" {
+		t.Fatalf("action = %v, want Propose", action)
+	}
+	var r pb.InternalRaftRequest
+	if err := r.Unmarshal(action[len(action)-1].Params[0].([]byte)); err != nil {
+		t.Fatalf("unmarshal request error: %v", err)
+	}
+	want := &pb.AlarmRequest{Action: waction, MemberID: 1, Alarm: pb.AlarmType_SLOW_WAL_FSYNC}
+	if !reflect.DeepEqual(r.Alarm, want) {
+		t.Fatalf("alarm request = %v, want %v", r.Alarm, want)
+	}
+	if _, err := (&applierV3backend{s: srv}).Alarm(r.Alarm); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// nodeTransferLeadershipRecorder records leadership transfers and
+// reports the transferee as the new leader right away.
+type nodeTransferLeadershipRecorder struct {
+	*nodeRecorder
+	setLead func(lead uint64)
+}
+
+func (n *nodeTransferLeadershipRecorder) TransferLeadership(ctx context.Context, lead, transferee uint64) {
+	n.Record(testutil.Action{Name: "TransferLeadership", Params: []interface{}{lead, transferee}})
+	n.setLead(transferee)
+}
+
+// activeTransporter reports a single peer as active for a long time.
+type activeTransporter struct {
+	nopTransporter
+	active types.ID
+}
+
+func (s *activeTransporter) ActiveSince(id types.ID) time.Time {
+	if id != s.active {
+		return time.Time{}
+	}
+	return time.Now().Add(-time.Hour)
+}
@@ -99,6 +99,9 @@ type raftNode struct {
 	ticker *time.Ticker
 	// contention detectors for raft heartbeat message
 	td *contention.TimeoutDetector
+	// fsyncDetector, if set, observes the duration of every raft
+	// storage save that persists entries or hard state.
+	fsyncDetector *walFsyncDetector
 
 	stopped chan struct{}
 	done    chan struct{}
@@ -169,6 +172,13 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 	go func() {
 		defer r.onStop()
 		islead := false
+		// term is the latest raft term observed by the loop
+		var term uint64
+		if r.raftStorage != nil {
+			if hs, _, err := r.raftStorage.InitialState(); err == nil {
+				term = hs.Term
+			}
+		}
 
 		for {
 			select {
@@ -198,6 +208,17 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 					r.td.Reset()
 				}
 
+				if !raft.IsEmptyHardState(rd.HardState) && rd.HardState.Term > term {
+					raftTermChanges.Inc()
+					r.lg.Info(
+						"raft term changed",
+						zap.Uint64("old-term", term),
+						zap.Uint64("new-term", rd.HardState.Term),
+						zap.String("leader", types.ID(rh.getLead()).String()),
+					)
+					term = rd.HardState.Term
+				}
+
 				if len(rd.ReadStates) != 0 {
 					select {
 					case r.readStateC <- rd.ReadStates[len(rd.ReadStates)-1]:
@@ -242,9 +263,13 @@ func (r *raftNode) start(rh *raftReadyHandler) {
 				}
 
 				// gofail: var raftBeforeSave struct{}
+				saveStart := time.Now()
 				if err := r.storage.Save(rd.HardState, rd.Entries); err != nil {
 					r.lg.Fatal("failed to save Raft hard state and entries", zap.Error(err))
 				}
+				if r.fsyncDetector != nil && (len(rd.Entries) != 0 || !raft.IsEmptyHardState(rd.HardState)) {
+					r.fsyncDetector.Observe(time.Since(saveStart))
+				}
 				if !raft.IsEmptyHardState(rd.HardState) {
 					proposalsCommitted.Set(float64(rd.HardState.Commit))
 				}
@@ -420,7 +445,21 @@ func (r *raftNode) advanceTicks(ticks int) {
 	}
 }
 
-func startNode(cfg config.ServerConfig, cl *membership.RaftCluster, ids []types.ID) (id types.ID, n raft.Node, s *raft.MemoryStorage, w *wal.WAL) {
+func startNode(cfg config.ServerConfig, cl *membership.RaftCluster, ids []types.ID, cg *campaignGuard) (id types.ID, n raft.Node, s *raft.MemoryStorage, w *wal.WAL) {
+	peers := make([]raft.Peer, len(ids))
+	for i, id := range ids {
+		ctx, err := json.Marshal((*cl).Member(id))
+		if err != nil {
+			cfg.Logger.Panic("failed to marshal member", zap.Error(err))
+		}
+		peers[i] = raft.Peer{ID: uint64(id), Context: ctx}
+	}
+	return startNodeWithPeers(cfg, cl, peers, cg)
+}
+
+// startNodeWithPeers creates the WAL and starts the local raft node,
+// bootstrapping it with the given peers.
+func startNodeWithPeers(cfg config.ServerConfig, cl *membership.RaftCluster, peers []raft.Peer, cg *campaignGuard) (id types.ID, n raft.Node, s *raft.MemoryStorage, w *wal.WAL) {
 	var err error
 	member := cl.MemberByName(cfg.Name)
 	metadata := pbutil.MustMarshal(
@@ -435,15 +474,6 @@ func startNode(cfg config.ServerConfig, cl *membership.RaftCluster, ids []types.
 	if cfg.UnsafeNoFsync {
 		w.SetUnsafeNoFsync()
 	}
-	peers := make([]raft.Peer, len(ids))
-	for i, id := range ids {
-		var ctx []byte
-		ctx, err = json.Marshal((*cl).Member(id))
-		if err != nil {
-			cfg.Logger.Panic("failed to marshal member", zap.Error(err))
-		}
-		peers[i] = raft.Peer{ID: uint64(id), Context: ctx}
-	}
 	id = member.ID
 	cfg.Logger.Info(
 		"starting local member",
@@ -461,6 +491,7 @@ func startNode(cfg config.ServerConfig, cl *membership.RaftCluster, ids []types.
 		CheckQuorum:     true,
 		PreVote:         cfg.PreVote,
 		Logger:          NewRaftLoggerZap(cfg.Logger.Named("raft")),
+		CampaignGuard:   cg.allow,
 	}
 	if len(peers) == 0 {
 		n = raft.RestartNode(c)
@@ -473,7 +504,7 @@ func startNode(cfg config.ServerConfig, cl *membership.RaftCluster, ids []types.
 	return id, n, s, w
 }
 
-func restartNode(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (types.ID, *membership.RaftCluster, raft.Node, *raft.MemoryStorage, *wal.WAL) {
+func restartNode(cfg config.ServerConfig, snapshot *raftpb.Snapshot, cg *campaignGuard) (types.ID, *membership.RaftCluster, raft.Node, *raft.MemoryStorage, *wal.WAL) {
 	var walsnap walpb.Snapshot
 	if snapshot != nil {
 		walsnap.Index, walsnap.Term = snapshot.Metadata.Index, snapshot.Metadata.Term
@@ -504,6 +535,7 @@ func restartNode(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (types.ID,
 		CheckQuorum:     true,
 		PreVote:         cfg.PreVote,
 		Logger:          NewRaftLoggerZap(cfg.Logger.Named("raft")),
+		CampaignGuard:   cg.allow,
 	}
 
 	n := raft.RestartNode(c)
@@ -513,7 +545,7 @@ func restartNode(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (types.ID,
 	return id, cl, n, s, w
 }
 
-func restartAsStandaloneNode(cfg config.ServerConfig, snapshot *raftpb.Snapshot) (types.ID, *membership.RaftCluster, raft.Node, *raft.MemoryStorage, *wal.WAL) {
+func restartAsStandaloneNode(cfg config.ServerConfig, snapshot *raftpb.Snapshot, cg *campaignGuard) (types.ID, *membership.RaftCluster, raft.Node, *raft.MemoryStorage, *wal.WAL) {
 	var walsnap walpb.Snapshot
 	if snapshot != nil {
 		walsnap.Index, walsnap.Term = snapshot.Metadata.Index, snapshot.Metadata.Term
@@ -578,6 +610,7 @@ func restartAsStandaloneNode(cfg config.ServerConfig, snapshot *raftpb.Snapshot)
 		CheckQuorum:     true,
 		PreVote:         cfg.PreVote,
 		Logger:          NewRaftLoggerZap(cfg.Logger.Named("raft")),
+		CampaignGuard:   cg.allow,
 	}
 
 	n := raft.RestartNode(c)
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/pbutil"
 	"go.etcd.io/etcd/raft/v3"
@@ -228,6 +229,97 @@ func TestConfigChangeBlocksApply(t *testing.T) {
 	}
 }
 
+// TestRaftTermChanges ensures the term change counter is incremented once
+// for each term increase observed by the raft loop.
+func TestRaftTermChanges(t *testing.T) {
+	n := newNopReadyNode()
+	rs := raft.NewMemoryStorage()
+	rs.SetHardState(raftpb.HardState{Term: 1})
+	r := newRaftNode(raftNodeConfig{
+		lg:          zap.NewExample(),
+		Node:        n,
+		storage:     mockstorage.NewStorageRecorder(""),
+		raftStorage: rs,
+		transport:   newNopTransporter(),
+	})
+	srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: zap.NewExample(), r: *r}
+
+	var lead uint64
+	srv.r.start(&raftReadyHandler{
+		getLead:          func() uint64 { return lead },
+		updateLead:       func(l uint64) { lead = l },
+		updateLeadership: func(bool) {},
+	})
+	defer srv.r.Stop()
+
+	tests := []struct {
+		ss *raft.SoftState
+		hs raftpb.HardState
+
+		wchanges float64
+	}{
+		{&raft.SoftState{RaftState: raft.StateCandidate}, raftpb.HardState{Term: 2, Vote: 1}, 1},
+		{&raft.SoftState{Lead: 2, RaftState: raft.StateFollower}, raftpb.HardState{Term: 2, Vote: 1, Commit: 1}, 1},
+		{&raft.SoftState{RaftState: raft.StateCandidate}, raftpb.HardState{Term: 3, Vote: 1, Commit: 1}, 2},
+		{&raft.SoftState{Lead: 2, RaftState: raft.StateFollower}, raftpb.HardState{Term: 5, Commit: 2}, 3},
+	}
+	start := promtestutil.ToFloat64(raftTermChanges)
+	for i, tt := range tests {
+		n.readyc <- raft.Ready{SoftState: tt.ss, HardState: tt.hs}
+		<-srv.r.applyc
+
+		if g := promtestutil.ToFloat64(raftTermChanges) - start; g != tt.wchanges {
+			t.Errorf("#%d: term changes = %v, want %v", i, g, tt.wchanges)
+		}
+	}
+}
+
+// TestRaftObservesWALFsync ensures that the raft loop reports the duration
+// of storage saves to the WAL fsync detector.
+func TestRaftObservesWALFsync(t *testing.T) {
+	n := newNopReadyNode()
+	slowc := make(chan time.Duration, 1)
+	r := newRaftNode(raftNodeConfig{
+		lg:          zap.NewExample(),
+		Node:        n,
+		storage:     &slowSaveStorage{Storage: mockstorage.NewStorageRecorder(""), delay: time.Millisecond},
+		raftStorage: raft.NewMemoryStorage(),
+		transport:   newNopTransporter(),
+	})
+	r.fsyncDetector = newWALFsyncDetector(zap.NewExample(), 100*time.Microsecond, func(avg time.Duration) { slowc <- avg }, nil)
+	srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: zap.NewExample(), r: *r}
+	srv.r.start(&raftReadyHandler{
+		getLead:          func() uint64 { return 0 },
+		updateLead:       func(uint64) {},
+		updateLeadership: func(bool) {},
+	})
+	defer srv.r.Stop()
+
+	for i := 0; i < walFsyncWindow+walFsyncSustain-1; i++ {
+		n.readyc <- raft.Ready{HardState: raftpb.HardState{Term: 1, Commit: uint64(i)}}
+		<-srv.r.applyc
+	}
+	select {
+	case avg := <-slowc:
+		if avg < time.Millisecond {
+			t.Errorf("average fsync duration = %v, want >= %v", avg, time.Millisecond)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("WAL fsyncs are not reported slow")
+	}
+}
+
+// slowSaveStorage delays every Save by delay.
+type slowSaveStorage struct {
+	Storage
+	delay time.Duration
+}
+
+func (s *slowSaveStorage) Save(st raftpb.HardState, ents []raftpb.Entry) error {
+	time.Sleep(s.delay)
+	return s.Storage.Save(st, ents)
+}
+
 func TestProcessDuplicatedAppRespMessage(t *testing.T) {
 	n := newNopReadyNode()
 	cl := membership.NewCluster(zap.NewExample())
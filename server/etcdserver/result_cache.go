@@ -0,0 +1,137 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// resultCache retains the results of recently applied requests by request id.
+// It holds at most size results, each for at most ttl. Results are evicted
+// in the order they were applied. A nil *resultCache caches nothing.
+//
+// Responses are copied in and out of the cache; the response handed to the
+// waiter of a request is filled in by the API layer while it is sent, and
+// callers of get must not share a response either.
+type resultCache struct {
+	size uint
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu sync.Mutex
+	// ll holds *resultCacheEntry, oldest first.
+	ll      *list.List
+	entries map[uint64]*list.Element
+}
+
+type resultCacheEntry struct {
+	id      uint64
+	resp    proto.Message
+	err     error
+	applied time.Time
+}
+
+func newResultCache(size uint, ttl time.Duration) *resultCache {
+	return &resultCache{
+		size:    size,
+		ttl:     ttl,
+		now:     time.Now,
+		ll:      list.New(),
+		entries: make(map[uint64]*list.Element),
+	}
+}
+
+// add caches the result of the request with the given id.
+func (c *resultCache) add(id uint64, ar *applyResult) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if e, ok := c.entries[id]; ok {
+		c.ll.Remove(e)
+	}
+	c.entries[id] = c.ll.PushBack(&resultCacheEntry{id: id, resp: cloneResponse(ar.resp), err: ar.err, applied: now})
+	c.evict(now)
+}
+
+// get returns a copy of the cached result of the request with the given id.
+func (c *resultCache) get(id uint64) (*AppliedResult, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.evict(c.now())
+	e, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	re := e.Value.(*resultCacheEntry)
+	return &AppliedResult{Response: cloneResponse(re.resp), Err: re.err}, true
+}
+
+func cloneResponse(resp proto.Message) proto.Message {
+	if resp == nil {
+		return nil
+	}
+	return proto.Clone(resp)
+}
+
+// clear drops all cached results.
+func (c *resultCache) clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.entries = make(map[uint64]*list.Element)
+}
+
+// evict drops the results over size and those applied more than ttl ago.
+func (c *resultCache) evict(now time.Time) {
+	for e := c.ll.Front(); e != nil; e = c.ll.Front() {
+		re := e.Value.(*resultCacheEntry)
+		if uint(c.ll.Len()) <= c.size && now.Sub(re.applied) < c.ttl {
+			return
+		}
+		c.ll.Remove(e)
+		delete(c.entries, re.id)
+	}
+}
+
+// AppliedResult is the result of an applied request.
+type AppliedResult struct {
+	// Response is the response to the request. It is nil if Err is set.
+	Response proto.Message
+	// Err is the error the request failed with.
+	Err error
+}
+
+// LastResultForRequestID returns the result of the recently applied request
+// with the given id, if it is still retained. A client that lost the response
+// to a committed proposal can use it instead of proposing the request again.
+// The returned response is owned by the caller.
+func (s *EtcdServer) LastResultForRequestID(id uint64) (*AppliedResult, bool) {
+	return s.resultCache.get(id)
+}
@@ -0,0 +1,195 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/pkg/v3/wait"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestLastResultForRequestID(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	wresp := &pb.PutResponse{Header: &pb.ResponseHeader{Revision: 2}}
+	srv := &EtcdServer{
+		lgMu:         new(sync.RWMutex),
+		lg:           lg,
+		w:            wait.New(),
+		consistIndex: cindex.NewFakeConsistentIndex(0),
+		applyV3:      &applierV3Result{ar: &applyResult{resp: wresp}},
+		resultCache:  newResultCache(10, time.Minute),
+	}
+
+	req := &pb.InternalRaftRequest{
+		Header: &pb.RequestHeader{ID: 7},
+		Put:    &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")},
+	}
+	srv.applyEntryNormal(&raftpb.Entry{Index: 1, Term: 1, Data: pbutil.MustMarshal(req)})
+
+	want := proto.Clone(wresp)
+	// the API layer fills in the header of the response sent to the proposer
+	wresp.Header.MemberId = 1
+
+	ar, ok := srv.LastResultForRequestID(7)
+	if !ok {
+		t.Fatalf("result for request 7 not found")
+	}
+	if !proto.Equal(ar.Response, want) || ar.Err != nil {
+		t.Errorf("result = %+v, want response %v", ar, want)
+	}
+	// callers own the returned response
+	ar.Response.(*pb.PutResponse).Header.Revision = 3
+	if ar, _ = srv.LastResultForRequestID(7); !proto.Equal(ar.Response, want) {
+		t.Errorf("result = %+v, want response %v", ar, want)
+	}
+	if _, ok = srv.LastResultForRequestID(8); ok {
+		t.Errorf("unexpected result for request 8")
+	}
+
+	// re-applying an applied entry must not replace the cached result
+	srv.applyV3 = &applierV3Result{ar: &applyResult{err: ErrTimeout}}
+	srv.applyEntryNormal(&raftpb.Entry{Index: 1, Term: 1, Data: pbutil.MustMarshal(req)})
+	if ar, _ = srv.LastResultForRequestID(7); !proto.Equal(ar.Response, want) {
+		t.Errorf("result = %+v, want response %v", ar, want)
+	}
+}
+
+// TestLastResultForRequestIDKeepsTxnRanges ensures that a member without a
+// waiter for a txn still applies its ranges when results are retained, so the
+// retained result matches the response of the proposing member.
+func TestLastResultForRequestIDKeepsTxnRanges(t *testing.T) {
+	tests := []struct {
+		resultCache *resultCache
+
+		wranges int
+	}{
+		{nil, 0},
+		{newResultCache(10, time.Minute), 1},
+	}
+	for i, tt := range tests {
+		applier := &applierV3Result{ar: &applyResult{resp: &pb.TxnResponse{}}}
+		srv := &EtcdServer{
+			lgMu:         new(sync.RWMutex),
+			lg:           zaptest.NewLogger(t),
+			w:            wait.New(),
+			consistIndex: cindex.NewFakeConsistentIndex(0),
+			applyV3:      applier,
+			resultCache:  tt.resultCache,
+		}
+		req := &pb.InternalRaftRequest{
+			Header: &pb.RequestHeader{ID: 7},
+			Txn: &pb.TxnRequest{Success: []*pb.RequestOp{
+				{Request: &pb.RequestOp_RequestRange{RequestRange: &pb.RangeRequest{Key: []byte("foo")}}},
+				{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("foo")}}},
+			}},
+		}
+		srv.applyEntryNormal(&raftpb.Entry{Index: 1, Term: 1, Data: pbutil.MustMarshal(req)})
+
+		ranges := 0
+		for _, op := range applier.last.Txn.Success {
+			if op.GetRequestRange() != nil {
+				ranges++
+			}
+		}
+		if ranges != tt.wranges {
+			t.Errorf("#%d: applied ranges = %d, want %d", i, ranges, tt.wranges)
+		}
+	}
+}
+
+func TestLastResultForRequestIDDisabled(t *testing.T) {
+	srv := &EtcdServer{
+		lgMu:         new(sync.RWMutex),
+		lg:           zaptest.NewLogger(t),
+		w:            wait.New(),
+		consistIndex: cindex.NewFakeConsistentIndex(0),
+		applyV3:      &applierV3Result{ar: &applyResult{resp: &pb.PutResponse{}}},
+	}
+	req := &pb.InternalRaftRequest{Header: &pb.RequestHeader{ID: 7}, Put: &pb.PutRequest{Key: []byte("foo")}}
+	srv.applyEntryNormal(&raftpb.Entry{Index: 1, Term: 1, Data: pbutil.MustMarshal(req)})
+
+	if _, ok := srv.LastResultForRequestID(7); ok {
+		t.Errorf("unexpected result with result retention disabled")
+	}
+}
+
+func TestResultCacheEvictsBySize(t *testing.T) {
+	c := newResultCache(2, time.Minute)
+	for id := uint64(1); id <= 3; id++ {
+		c.add(id, &applyResult{})
+	}
+	if _, ok := c.get(1); ok {
+		t.Errorf("oldest result is not evicted")
+	}
+	for _, id := range []uint64{2, 3} {
+		if _, ok := c.get(id); !ok {
+			t.Errorf("result for request %d not found", id)
+		}
+	}
+}
+
+func TestResultCacheEvictsByTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := newResultCache(10, time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.add(1, &applyResult{})
+	now = now.Add(30 * time.Second)
+	c.add(2, &applyResult{})
+
+	now = now.Add(30 * time.Second)
+	if _, ok := c.get(1); ok {
+		t.Errorf("expired result is not evicted")
+	}
+	if _, ok := c.get(2); !ok {
+		t.Errorf("result for request 2 not found")
+	}
+
+	now = now.Add(30 * time.Second)
+	if _, ok := c.get(2); ok {
+		t.Errorf("expired result is not evicted")
+	}
+}
+
+func TestResultCacheClear(t *testing.T) {
+	c := newResultCache(10, time.Minute)
+	c.add(1, &applyResult{})
+	c.clear()
+	if _, ok := c.get(1); ok {
+		t.Errorf("result is not cleared")
+	}
+}
+
+// applierV3Result applies every request with the same result.
+type applierV3Result struct {
+	applierV3
+	ar *applyResult
+	// last is the last applied request.
+	last *pb.InternalRaftRequest
+}
+
+func (a *applierV3Result) Apply(r *pb.InternalRaftRequest, shouldApplyV3 membership.ShouldApplyV3) *applyResult {
+	a.last = r
+	return a.ar
+}
@@ -43,6 +43,7 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/idutil"
+	"go.etcd.io/etcd/pkg/v3/netutil"
 	"go.etcd.io/etcd/pkg/v3/pbutil"
 	"go.etcd.io/etcd/pkg/v3/runtime"
 	"go.etcd.io/etcd/pkg/v3/schedule"
@@ -79,6 +80,17 @@ const (
 	// follower to catch up.
 	DefaultSnapshotCatchUpEntries uint64 = 5000
 
+	// DefaultNospaceAlarmLowWatermark is the fraction of the backend quota
+	// the backend size must fall below before the NOSPACE alarm is cleared.
+	DefaultNospaceAlarmLowWatermark = 0.8
+
+	// StaleTermEntryProcess applies a committed entry whose term predates the
+	// latest snapshot or membership change as any other entry.
+	StaleTermEntryProcess = "process"
+	// StaleTermEntrySkip skips a committed entry whose term predates the
+	// latest snapshot or membership change, failing its pending request.
+	StaleTermEntrySkip = "skip"
+
 	StoreClusterPrefix = "/0"
 	StoreKeysPrefix    = "/1"
 
@@ -207,6 +219,7 @@ type EtcdServer struct {
 	committedIndex    uint64 // must use atomic operations to access; keep 64-bit aligned.
 	term              uint64 // must use atomic operations to access; keep 64-bit aligned.
 	lead              uint64 // must use atomic operations to access; keep 64-bit aligned.
+	watchStreams      int64  // must use atomic operations to access; keep 64-bit aligned.
 
 	consistIndex cindex.ConsistentIndexer // consistIndex is used to get/set/save consistentIndex
 	r            raftNode                 // uses 64-bit atomics; keep 64-bit aligned.
@@ -292,6 +305,29 @@ type EtcdServer struct {
 	firstCommitInTermMu sync.RWMutex
 	firstCommitInTermC  chan struct{}
 
+	// walFsyncAlarmMu serializes updates of the SLOW_WAL_FSYNC alarm.
+	walFsyncAlarmMu sync.Mutex
+
+	// bootstrapMu serializes BootstrapSingleNode with itself and with
+	// AwaitingBootstrap.
+	bootstrapMu sync.Mutex
+
+	// resultCache retains recently applied results by request id.
+	// It is nil if result retention is disabled.
+	resultCache *resultCache
+
+	// startTime is when the server started.
+	startTime time.Time
+	// restartCount is the number of times the member has started on its backend.
+	restartCount uint64
+
+	// confStateTerm is the term of the latest snapshot or applied
+	// configuration change. It is only accessed by the apply routine.
+	confStateTerm uint64
+
+	// campaignGuard vetoes leadership campaigns of the local raft node.
+	campaignGuard *campaignGuard
+
 	*AccessController
 }
 
@@ -336,6 +372,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		s  *raft.MemoryStorage
 		id types.ID
 		cl *membership.RaftCluster
+		cg = &campaignGuard{}
 	)
 
 	if cfg.MaxRequestBytes > recommendedMaxRequestBytes {
@@ -429,7 +466,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		cl.SetID(types.ID(0), existingCluster.ID())
 		cl.SetStore(st)
 		cl.SetBackend(be)
-		id, n, s, w = startNode(cfg, cl, nil)
+		id, n, s, w = startNode(cfg, cl, nil, cg)
 		cl.SetID(id, existingCluster.ID())
 
 	case !haveWAL && cfg.NewCluster:
@@ -464,7 +501,16 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		}
 		cl.SetStore(st)
 		cl.SetBackend(be)
-		id, n, s, w = startNode(cfg, cl, cl.MemberIDs())
+		if cfg.ManualBootstrap {
+			if len(cl.Members()) != 1 {
+				return nil, fmt.Errorf("manual bootstrap requires the initial cluster %s to only contain the local member", cfg.InitialPeerURLsMap)
+			}
+			// the raft node is started by BootstrapSingleNode
+			id = cl.MemberByName(cfg.Name).ID
+			cl.SetID(id, cl.ID())
+			break
+		}
+		id, n, s, w = startNode(cfg, cl, cl.MemberIDs(), cg)
 		cl.SetID(id, cl.ID())
 
 	case haveWAL:
@@ -527,9 +573,9 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		}
 
 		if !cfg.ForceNewCluster {
-			id, cl, n, s, w = restartNode(cfg, snapshot)
+			id, cl, n, s, w = restartNode(cfg, snapshot, cg)
 		} else {
-			id, cl, n, s, w = restartAsStandaloneNode(cfg, snapshot)
+			id, cl, n, s, w = restartAsStandaloneNode(cfg, snapshot, cg)
 		}
 
 		cl.SetStore(st)
@@ -581,9 +627,18 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		AccessController:   &AccessController{CORS: cfg.CORS, HostWhitelist: cfg.HostWhitelist},
 		consistIndex:       ci,
 		firstCommitInTermC: make(chan struct{}),
+		campaignGuard:      cg,
 	}
 	serverID.With(prometheus.Labels{"server_id": id.String()}).Set(1)
 
+	if cfg.WALFsyncAlarmThreshold > 0 {
+		srv.r.fsyncDetector = newWALFsyncDetector(cfg.Logger, cfg.WALFsyncAlarmThreshold, srv.onSlowWALFsync, srv.onWALFsyncRecovered)
+	}
+
+	if cfg.ResultCacheSize > 0 && cfg.ResultCacheTTL > 0 {
+		srv.resultCache = newResultCache(cfg.ResultCacheSize, cfg.ResultCacheTTL)
+	}
+
 	srv.applyV2 = NewApplierV2(cfg.Logger, srv.v2store, srv.cluster)
 
 	srv.be = be
@@ -687,6 +742,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	}
 	srv.r.transport = tr
 
+	srv.markStarted()
 	return srv, nil
 }
 
@@ -786,6 +842,11 @@ func (s *EtcdServer) adjustTicks() {
 // should be implemented in goroutines.
 func (s *EtcdServer) Start() {
 	s.start()
+	s.startRoutines()
+}
+
+// startRoutines starts the long-running goroutines of a started server.
+func (s *EtcdServer) startRoutines() {
 	s.GoAttach(func() { s.adjustTicks() })
 	// TODO: Switch to publishV3 in 3.6.
 	// Support for cluster_member_set_attr was added in 3.5.
@@ -796,6 +857,89 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(s.linearizableReadLoop)
 	s.GoAttach(s.monitorKVHash)
 	s.GoAttach(s.monitorDowngrade)
+	s.GoAttach(s.monitorNospaceAlarm)
+}
+
+// BootstrapSingleNode bootstraps a fresh data dir as a single-node cluster
+// and starts the server in place of Start. It adds the given member as the
+// sole voting member, sets the cluster version to the version of this
+// binary, and returns once the server is ready to serve client requests,
+// or ctx is done. The server must be created with Cfg.ManualBootstrap set,
+// and the member must be the local member as given by the initial cluster
+// configuration. ErrDataDirNotEmpty is returned if the server has already
+// been started or the data dir already holds a WAL or applied data; such a
+// server is started with Start instead.
+func (s *EtcdServer) BootstrapSingleNode(ctx context.Context, m membership.Member) error {
+	local := s.cluster.Member(s.ID())
+	if local == nil || m.ID != local.ID || m.Name != local.Name || m.IsLearner {
+		return fmt.Errorf("cannot bootstrap with member %+v; local member is %+v", m, local)
+	}
+	if ok, err := netutil.URLStringsEqual(ctx, s.Logger(), m.PeerURLs, local.PeerURLs); !ok {
+		return fmt.Errorf("cannot bootstrap with member %s; peer URLs do not match the local member: %v", m.ID, err)
+	}
+
+	firstCommitInTerm := s.FirstCommitInTermNotify()
+	if err := s.startBootstrapNode(m); err != nil {
+		return err
+	}
+	// publish and version monitoring expect the local member to be known,
+	// so only start them once the member has been added and elected.
+	select {
+	case <-firstCommitInTerm:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.stopping:
+		return ErrStopped
+	}
+
+	v := semver.Must(semver.NewVersion(version.Version))
+	if err := s.setClusterVersionV2(ctx, (&semver.Version{Major: v.Major, Minor: v.Minor}).String()); err != nil {
+		return err
+	}
+
+	s.startRoutines()
+	select {
+	case <-s.ReadyNotify():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.stopping:
+		return ErrStopped
+	}
+}
+
+// startBootstrapNode starts the raft node of a server awaiting bootstrap
+// with m as its only member, and starts the server.
+func (s *EtcdServer) startBootstrapNode(m membership.Member) error {
+	s.bootstrapMu.Lock()
+	defer s.bootstrapMu.Unlock()
+	if s.r.Node != nil || wal.Exist(s.Cfg.WALDir()) || s.consistIndex.ConsistentIndex() != 0 {
+		return ErrDataDirNotEmpty
+	}
+
+	// the member is added through the configuration change that AddMember
+	// proposes. A cluster without members has no leader to commit it, so it
+	// is the raft bootstrap entry, as when a new cluster starts from its
+	// initial members.
+	cc, err := newAddMemberConfChange(m)
+	if err != nil {
+		return err
+	}
+	_, n, rs, w := startNodeWithPeers(s.Cfg, s.cluster, []raft.Peer{{ID: cc.NodeID, Context: cc.Context}}, s.campaignGuard)
+	s.r.Node = n
+	s.r.raftStorage = rs
+	s.r.storage = NewStorage(w, s.snapshotter)
+	s.start()
+	return nil
+}
+
+// AwaitingBootstrap returns true if the server was created with
+// Cfg.ManualBootstrap on a fresh data dir and has not been bootstrapped
+// by BootstrapSingleNode yet. Such a server must not be started with Start.
+func (s *EtcdServer) AwaitingBootstrap() bool {
+	s.bootstrapMu.Lock()
+	defer s.bootstrapMu.Unlock()
+	return s.r.Node == nil
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -820,6 +964,14 @@ func (s *EtcdServer) start() {
 		)
 		s.Cfg.SnapshotCatchUpEntries = DefaultSnapshotCatchUpEntries
 	}
+	if s.Cfg.NospaceAlarmCheckInterval > 0 && (s.Cfg.NospaceAlarmLowWatermark <= 0 || s.Cfg.NospaceAlarmLowWatermark >= 1) {
+		lg.Info(
+			"updating NOSPACE alarm low watermark to default",
+			zap.Float64("given-nospace-alarm-low-watermark", s.Cfg.NospaceAlarmLowWatermark),
+			zap.Float64("updated-nospace-alarm-low-watermark", DefaultNospaceAlarmLowWatermark),
+		)
+		s.Cfg.NospaceAlarmLowWatermark = DefaultNospaceAlarmLowWatermark
+	}
 
 	s.w = wait.New()
 	s.applyWait = wait.NewTimeList()
@@ -1078,6 +1230,7 @@ func (s *EtcdServer) run() {
 		appliedt:  sn.Metadata.Term,
 		appliedi:  sn.Metadata.Index,
 	}
+	s.confStateTerm = sn.Metadata.Term
 
 	defer func() {
 		s.wgMu.Lock() // block concurrent waitgroup adds in GoAttach while stopping
@@ -1234,6 +1387,9 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 	// wait for raftNode to persist snapshot onto the disk
 	<-apply.notifyc
 
+	// results applied before the snapshot may not reflect its state
+	s.resultCache.clear()
+
 	newbe, err := openSnapshotBackend(s.Cfg, s.snapshotter, apply.snapshot, s.beHooks)
 	if err != nil {
 		lg.Panic("failed to open snapshot backend", zap.Error(err))
@@ -1276,6 +1432,9 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 	s.be = newbe
 	s.bemu.Unlock()
 
+	// the restart count is local to the member; do not take the leader's.
+	saveRestartCount(newbe, s.restartCount)
+
 	lg.Info("restoring alarm store")
 
 	if err := s.restoreAlarms(); err != nil {
@@ -1331,6 +1490,7 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 	ep.appliedi = apply.snapshot.Metadata.Index
 	ep.snapi = ep.appliedi
 	ep.confState = apply.snapshot.Metadata.ConfState
+	s.confStateTerm = apply.snapshot.Metadata.Term
 }
 
 func (s *EtcdServer) applyEntries(ep *etcdProgress, apply *apply) {
@@ -1540,8 +1700,7 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) ([]*
 		return nil, err
 	}
 
-	// TODO: move Member to protobuf type
-	b, err := json.Marshal(memb)
+	cc, err := newAddMemberConfChange(memb)
 	if err != nil {
 		return nil, err
 	}
@@ -1551,6 +1710,18 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) ([]*
 		return nil, err
 	}
 
+	return s.configure(ctx, cc)
+}
+
+// newAddMemberConfChange returns the configuration change that adds memb
+// to the cluster.
+func newAddMemberConfChange(memb membership.Member) (raftpb.ConfChange, error) {
+	// TODO: move Member to protobuf type
+	b, err := json.Marshal(memb)
+	if err != nil {
+		return raftpb.ConfChange{}, err
+	}
+
 	cc := raftpb.ConfChange{
 		Type:    raftpb.ConfChangeAddNode,
 		NodeID:  uint64(memb.ID),
@@ -1560,8 +1731,7 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) ([]*
 	if memb.IsLearner {
 		cc.Type = raftpb.ConfChangeAddLearnerNode
 	}
-
-	return s.configure(ctx, cc)
+	return cc, nil
 }
 
 func (s *EtcdServer) mayAddMember(memb membership.Member) error {
@@ -1890,6 +2060,9 @@ func (s *EtcdServer) configure(ctx context.Context, cc raftpb.ConfChange) ([]*me
 	cc.ID = s.reqIDGen.Next()
 	ch := s.w.Register(cc.ID)
 
+	if s.Cfg.AuditProposals {
+		s.auditConfChange(cc)
+	}
 	start := time.Now()
 	if err := s.r.ProposeConfChange(ctx, cc); err != nil {
 		s.w.Trigger(cc.ID, nil)
@@ -1928,6 +2101,9 @@ func (s *EtcdServer) sync(timeout time.Duration) {
 		ID:     s.reqIDGen.Next(),
 		Time:   time.Now().UnixNano(),
 	}
+	if s.Cfg.AuditProposals {
+		s.auditV2Proposal(&req)
+	}
 	data := pbutil.MustMarshal(&req)
 	// There is no promise that node has leader when do SYNC request,
 	// so it uses goroutine to propose.
@@ -2111,6 +2287,13 @@ func (s *EtcdServer) apply(
 			zap.Uint64("index", e.Index),
 			zap.Uint64("term", e.Term),
 			zap.Stringer("type", e.Type))
+		if e.Term < s.confStateTerm && s.Cfg.StaleTermEntryAction == StaleTermEntrySkip {
+			s.skipStaleTermEntry(&e)
+			s.setAppliedIndex(e.Index)
+			s.setTerm(e.Term)
+			appliedi, appliedt = e.Index, e.Term
+			continue
+		}
 		switch e.Type {
 		case raftpb.EntryNormal:
 			s.applyEntryNormal(&e)
@@ -2131,6 +2314,9 @@ func (s *EtcdServer) apply(
 			var cc raftpb.ConfChange
 			pbutil.MustUnmarshal(&cc, e.Data)
 			removedSelf, err := s.applyConfChange(cc, confState, shouldApplyV3)
+			if err == nil {
+				s.confStateTerm = e.Term
+			}
 			s.setAppliedIndex(e.Index)
 			s.setTerm(e.Term)
 			shouldStop = shouldStop || removedSelf
@@ -2148,6 +2334,53 @@ func (s *EtcdServer) apply(
 	return appliedt, appliedi, shouldStop
 }
 
+// skipStaleTermEntry skips a committed entry whose term predates the term of
+// the latest snapshot or membership change. Entry terms never decrease in a
+// valid raft log, so this only happens when the applier is out of sync with
+// the snapshot. The consistent index still advances past the entry and its
+// pending request, if any, fails with ErrStaleTermEntry.
+func (s *EtcdServer) skipStaleTermEntry(e *raftpb.Entry) {
+	staleTermEntriesSkipped.Inc()
+	s.Logger().Warn(
+		"skipping committed entry with stale term",
+		zap.Uint64("index", e.Index),
+		zap.Uint64("term", e.Term),
+		zap.Uint64("conf-state-term", s.confStateTerm),
+		zap.Stringer("type", e.Type),
+	)
+	if e.Index > s.consistIndex.ConsistentIndex() {
+		s.consistIndex.SetConsistentIndex(e.Index, e.Term)
+	}
+
+	switch e.Type {
+	case raftpb.EntryNormal:
+		if len(e.Data) == 0 {
+			return
+		}
+		var raftReq pb.InternalRaftRequest
+		if !pbutil.MaybeUnmarshal(&raftReq, e.Data) { // backward compatible
+			var r pb.Request
+			pbutil.MustUnmarshal(&r, e.Data)
+			s.w.Trigger(r.ID, Response{Err: ErrStaleTermEntry})
+			return
+		}
+		if raftReq.V2 != nil {
+			s.w.Trigger(raftReq.V2.ID, Response{Err: ErrStaleTermEntry})
+			return
+		}
+		id := raftReq.ID
+		if id == 0 {
+			id = raftReq.Header.ID
+		}
+		s.w.Trigger(id, &applyResult{err: ErrStaleTermEntry})
+
+	case raftpb.EntryConfChange:
+		var cc raftpb.ConfChange
+		pbutil.MustUnmarshal(&cc, e.Data)
+		s.w.Trigger(cc.ID, &confChangeResponse{s.cluster.Members(), ErrStaleTermEntry})
+	}
+}
+
 // applyEntryNormal apples an EntryNormal type raftpb request to the EtcdServer
 func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry) {
 	shouldApplyV3 := membership.ApplyV2storeOnly
@@ -2200,7 +2433,8 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry) {
 	var ar *applyResult
 	needResult := s.w.IsRegistered(id)
 	if needResult || !noSideEffect(&raftReq) {
-		if !needResult && raftReq.Txn != nil {
+		// retained results must match the response the leader returns
+		if !needResult && s.resultCache == nil && raftReq.Txn != nil {
 			removeNeedlessRangeReqs(raftReq.Txn)
 		}
 		ar = s.applyV3.Apply(&raftReq, shouldApplyV3)
@@ -2214,6 +2448,7 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry) {
 	if ar == nil {
 		return
 	}
+	s.resultCache.add(id, ar)
 
 	if ar.err != ErrNoSpace || len(s.alarmStore.Get(pb.AlarmType_NOSPACE)) > 0 {
 		s.w.Trigger(id, ar)
@@ -2485,14 +2720,8 @@ func (s *EtcdServer) updateClusterVersionV2(ver string) {
 		)
 	}
 
-	req := pb.Request{
-		Method: "PUT",
-		Path:   membership.StoreClusterVersionKey(),
-		Val:    ver,
-	}
-
 	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
-	_, err := s.Do(ctx, req)
+	err := s.setClusterVersionV2(ctx, ver)
 	cancel()
 
 	switch err {
@@ -2509,6 +2738,17 @@ func (s *EtcdServer) updateClusterVersionV2(ver string) {
 	}
 }
 
+// setClusterVersionV2 sets the cluster version through raft using v2 API.
+func (s *EtcdServer) setClusterVersionV2(ctx context.Context, ver string) error {
+	req := pb.Request{
+		Method: "PUT",
+		Path:   membership.StoreClusterVersionKey(),
+		Val:    ver,
+	}
+	_, err := s.Do(ctx, req)
+	return err
+}
+
 func (s *EtcdServer) updateClusterVersionV3(ver string) {
 	lg := s.Logger()
 
@@ -2580,6 +2820,83 @@ func (s *EtcdServer) monitorDowngrade() {
 	}
 }
 
+// monitorNospaceAlarm periodically clears the NOSPACE alarm on the leader
+// once the backend size falls below the low watermark of the quota.
+func (s *EtcdServer) monitorNospaceAlarm() {
+	t := s.Cfg.NospaceAlarmCheckInterval
+	if t == 0 {
+		return
+	}
+	for {
+		select {
+		case <-time.After(t):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() {
+			continue
+		}
+		s.clearNospaceAlarm()
+	}
+}
+
+// clearNospaceAlarm deactivates the NOSPACE alarms through raft if the
+// backend size is below the low watermark of the quota. The alarm is raised
+// when the size reaches the quota, so the gap between the two keeps the alarm
+// from flapping.
+func (s *EtcdServer) clearNospaceAlarm() {
+	alarms := s.alarmStore.Get(pb.AlarmType_NOSPACE)
+	if len(alarms) == 0 {
+		return
+	}
+
+	quota := s.Cfg.QuotaBackendBytes
+	if quota < 0 {
+		// quota is disabled
+		return
+	}
+	if quota == 0 {
+		quota = DefaultQuotaBytes
+	}
+	lg := s.Logger()
+	size := s.Backend().Size()
+	watermark := int64(float64(quota) * s.Cfg.NospaceAlarmLowWatermark)
+	if size >= watermark {
+		lg.Debug(
+			"backend size is above the NOSPACE alarm low watermark",
+			zap.Int64("backend-size-bytes", size),
+			zap.Int64("low-watermark-bytes", watermark),
+		)
+		return
+	}
+
+	lg.Info(
+		"backend size fell below the NOSPACE alarm low watermark; clearing alarm",
+		zap.Int64("backend-size-bytes", size),
+		zap.String("backend-size", humanize.Bytes(uint64(size))),
+		zap.Int64("low-watermark-bytes", watermark),
+		zap.String("low-watermark", humanize.Bytes(uint64(watermark))),
+	)
+	for _, m := range alarms {
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		_, err := s.Alarm(ctx, &pb.AlarmRequest{
+			Action:   pb.AlarmRequest_DEACTIVATE,
+			MemberID: m.MemberID,
+			Alarm:    pb.AlarmType_NOSPACE,
+		})
+		cancel()
+		if err != nil {
+			lg.Warn(
+				"failed to clear NOSPACE alarm",
+				zap.String("alarm-member-id", types.ID(m.MemberID).String()),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+}
+
 func (s *EtcdServer) parseProposeCtxErr(err error, start time.Time) error {
 	switch err {
 	case context.Canceled:
@@ -2635,6 +2952,17 @@ func (s *EtcdServer) restoreAlarms() error {
 	if len(as.Get(pb.AlarmType_CORRUPT)) > 0 {
 		s.applyV3 = newApplierV3Corrupt(s.applyV3)
 	}
+	if s.r.fsyncDetector != nil {
+		// resume from the persisted alarm so that a member restarted while
+		// its fsyncs were slow still clears the alarm once they recover.
+		slow := false
+		for _, m := range as.Get(pb.AlarmType_SLOW_WAL_FSYNC) {
+			if types.ID(m.MemberID) == s.ID() {
+				slow = true
+			}
+		}
+		s.r.fsyncDetector.setSlow(slow)
+	}
 	return nil
 }
 
@@ -29,9 +29,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/go-semver/semver"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
+	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -46,6 +49,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/mock/mockstorage"
@@ -702,6 +706,82 @@ func TestApplyConfigChangeUpdatesConsistIndex(t *testing.T) {
 	assert.Equal(t, uint64(4), rterm)
 }
 
+// TestApplyStaleTermEntry ensures a committed entry whose term predates the
+// membership configuration is processed or skipped as configured.
+func TestApplyStaleTermEntry(t *testing.T) {
+	tests := []struct {
+		action string
+
+		wapplied bool
+		wskipped float64
+	}{
+		{"", true, 0},
+		{StaleTermEntryProcess, true, 0},
+		{StaleTermEntrySkip, false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			lg := zaptest.NewLogger(t)
+			srv := &EtcdServer{
+				lgMu:          new(sync.RWMutex),
+				lg:            lg,
+				Cfg:           config.ServerConfig{Logger: lg, StaleTermEntryAction: tt.action},
+				w:             wait.New(),
+				consistIndex:  cindex.NewFakeConsistentIndex(0),
+				applyV3:       &applierV3Result{ar: &applyResult{resp: &pb.PutResponse{}}},
+				resultCache:   newResultCache(10, time.Minute),
+				confStateTerm: 5,
+			}
+
+			// entry terms never decrease in a valid log; the first entry is stale
+			ents := []raftpb.Entry{
+				{Index: 1, Term: 3, Data: pbutil.MustMarshal(&pb.InternalRaftRequest{Header: &pb.RequestHeader{ID: 1}, Put: &pb.PutRequest{Key: []byte("foo")}})},
+				{Index: 2, Term: 5, Data: pbutil.MustMarshal(&pb.InternalRaftRequest{Header: &pb.RequestHeader{ID: 2}, Put: &pb.PutRequest{Key: []byte("foo")}})},
+			}
+			ch := srv.w.Register(1)
+			skipped := promtestutil.ToFloat64(staleTermEntriesSkipped)
+			appliedt, appliedi, _ := srv.apply(ents[:1], &raftpb.ConfState{})
+
+			if appliedi != 1 || appliedt != 3 {
+				t.Errorf("applied index/term = %d/%d, want 1/3", appliedi, appliedt)
+			}
+			if g := srv.getAppliedIndex(); g != 1 {
+				t.Errorf("getAppliedIndex = %d, want 1", g)
+			}
+			if g := srv.consistIndex.ConsistentIndex(); g != 1 {
+				t.Errorf("consistent index = %d, want 1", g)
+			}
+			if _, applied := srv.LastResultForRequestID(1); applied != tt.wapplied {
+				t.Errorf("stale entry applied = %v, want %v", applied, tt.wapplied)
+			}
+			if g := promtestutil.ToFloat64(staleTermEntriesSkipped) - skipped; g != tt.wskipped {
+				t.Errorf("skipped stale term entries = %v, want %v", g, tt.wskipped)
+			}
+			select {
+			case x := <-ch:
+				ar := x.(*applyResult)
+				if tt.wapplied && ar.err != nil {
+					t.Errorf("stale entry result error = %v, want nil", ar.err)
+				}
+				if !tt.wapplied && ar.err != ErrStaleTermEntry {
+					t.Errorf("stale entry result error = %v, want %v", ar.err, ErrStaleTermEntry)
+				}
+			default:
+				t.Errorf("waiter of the stale entry is not triggered")
+			}
+
+			// entries after the stale entry are applied as usual
+			appliedt, appliedi, _ = srv.apply(ents[1:], &raftpb.ConfState{})
+			if appliedi != 2 || appliedt != 5 {
+				t.Errorf("applied index/term = %d/%d, want 2/5", appliedi, appliedt)
+			}
+			if _, applied := srv.LastResultForRequestID(2); !applied {
+				t.Errorf("entry of request 2 is not applied")
+			}
+		})
+	}
+}
+
 func realisticRaftNode(lg *zap.Logger) *raftNode {
 	storage := raft.NewMemoryStorage()
 	storage.SetHardState(raftpb.HardState{Commit: 0, Term: 0})
@@ -1640,6 +1720,98 @@ func TestPublishV3(t *testing.T) {
 		Name: "node1", ClientUrls: []string{"http://a", "http://b"}}}, r.ClusterMemberAttrSet)
 }
 
+// TestClearNospaceAlarm tests that the NOSPACE alarm is cleared through raft
+// only once the backend size falls below the low watermark of the quota.
+func TestClearNospaceAlarm(t *testing.T) {
+	tests := []struct {
+		name string
+		// quota returns the backend quota for the given backend size.
+		quota  func(size int64) int64
+		wclear bool
+	}{
+		{
+			name:   "below low watermark",
+			quota:  func(size int64) int64 { return 2 * size },
+			wclear: true,
+		},
+		{
+			name:   "below quota but above low watermark",
+			quota:  func(size int64) int64 { return size + size/10 },
+			wclear: false,
+		},
+		{
+			name:   "above quota",
+			quota:  func(size int64) int64 { return size / 2 },
+			wclear: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := newNodeRecorder()
+			ch := make(chan interface{}, 1)
+			// simulate that request has gone through consensus
+			ch <- &applyResult{resp: &pb.AlarmResponse{}}
+			lg := zaptest.NewLogger(t)
+			be, _ := betesting.NewDefaultTmpBackend(t)
+			defer betesting.Close(t, be)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			srv := &EtcdServer{
+				lgMu:      new(sync.RWMutex),
+				lg:        lg,
+				Cfg:       config.ServerConfig{Logger: lg, TickMs: 1, MaxRequestBytes: 1000, NospaceAlarmLowWatermark: 0.8},
+				id:        1,
+				r:         *newRaftNode(raftNodeConfig{lg: lg, Node: n}),
+				w:         wait.NewWithResponse(ch),
+				reqIDGen:  idutil.NewGenerator(0, time.Time{}),
+				authStore: auth.NewAuthStore(lg, be, nil, 0),
+				be:        be,
+				ctx:       ctx,
+				cancel:    cancel,
+			}
+			as, err := v3alarm.NewAlarmStore(lg, srv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv.alarmStore = as
+			as.Activate(2, pb.AlarmType_NOSPACE)
+			srv.Cfg.QuotaBackendBytes = tt.quota(be.Size())
+
+			srv.clearNospaceAlarm()
+
+			action := n.Action()
+			if !tt.wclear {
+				if len(action) != 0 {
+					t.Fatalf("action = %v, want no action", action)
+				}
+				if len(as.Get(pb.AlarmType_NOSPACE)) != 1 {
+					t.Fatalf("NOSPACE alarm is cleared, want it raised")
+				}
+				return
+			}
+			if len(action) != 1 || action[0].Name != "Propose" {
+				t.Fatalf("action = %v, want [Propose]", action)
+			}
+			var r pb.InternalRaftRequest
+			if err := r.Unmarshal(action[0].Params[0].([]byte)); err != nil {
+				t.Fatalf("unmarshal request error: %v", err)
+			}
+			want := &pb.AlarmRequest{Action: pb.AlarmRequest_DEACTIVATE, MemberID: 2, Alarm: pb.AlarmType_NOSPACE}
+			if !reflect.DeepEqual(r.Alarm, want) {
+				t.Fatalf("alarm request = %v, want %v", r.Alarm, want)
+			}
+
+			// apply the proposed request
+			if _, err := (&applierV3backend{s: srv}).Alarm(r.Alarm); err != nil {
+				t.Fatal(err)
+			}
+			if alarms := as.Get(pb.AlarmType_NOSPACE); len(alarms) != 0 {
+				t.Errorf("NOSPACE alarms = %v, want cleared", alarms)
+			}
+		})
+	}
+}
+
 // TestPublishStopped tests that publish will be stopped if server is stopped.
 func TestPublishV3Stopped(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -1764,6 +1936,106 @@ func TestUpdateVersion(t *testing.T) {
 	}
 }
 
+// TestBootstrapSingleNode tests BootstrapSingleNode adds the local member,
+// sets the cluster version and brings a new server to serve requests.
+func TestBootstrapSingleNode(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	purls := types.MustNewURLs([]string{"http://127.0.0.1:2380"})
+	cfg := config.ServerConfig{
+		Name:                "node1",
+		DataDir:             t.TempDir(),
+		ClientURLs:          types.MustNewURLs([]string{"http://127.0.0.1:2379"}),
+		PeerURLs:            purls,
+		InitialPeerURLsMap:  types.URLsMap{"node1": purls},
+		InitialClusterToken: "bootstrap",
+		NewCluster:          true,
+		ManualBootstrap:     true,
+		TickMs:              1,
+		ElectionTicks:       10,
+		BootstrapTimeout:    10 * time.Millisecond,
+		MaxTxnOps:           128,
+		MaxRequestBytes:     recommendedMaxRequestBytes,
+		Logger:              lg,
+	}
+	s, err := NewServer(cfg)
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+
+	m := membership.NewMember(cfg.Name, purls, cfg.InitialClusterToken, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if !s.AwaitingBootstrap() {
+		t.Fatalf("AwaitingBootstrap = false before bootstrap, want true")
+	}
+
+	// members other than the one of the initial cluster are rejected
+	otherURLs := *m
+	otherURLs.PeerURLs = []string{"http://127.0.0.1:2381"}
+	learner := *m
+	learner.IsLearner = true
+	for _, bad := range []*membership.Member{
+		membership.NewMember("node2", purls, cfg.InitialClusterToken, nil),
+		&otherURLs,
+		&learner,
+	} {
+		if err = s.BootstrapSingleNode(ctx, *bad); err == nil {
+			t.Fatalf("BootstrapSingleNode(%+v) error = nil, want error", bad)
+		}
+	}
+	if !s.AwaitingBootstrap() {
+		t.Fatalf("AwaitingBootstrap = false after rejected bootstraps, want true")
+	}
+
+	// only one of concurrent bootstraps starts the server
+	errc := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() { errc <- s.BootstrapSingleNode(ctx, *m) }()
+	}
+	errs := []error{<-errc, <-errc}
+	if !(errs[0] == nil && errs[1] == ErrDataDirNotEmpty) && !(errs[0] == ErrDataDirNotEmpty && errs[1] == nil) {
+		t.Fatalf("concurrent BootstrapSingleNode errors = %v, want one nil and one %v", errs, ErrDataDirNotEmpty)
+	}
+	if s.AwaitingBootstrap() {
+		t.Errorf("AwaitingBootstrap = true after bootstrap, want false")
+	}
+
+	if mem := s.cluster.Member(m.ID); mem == nil || mem.IsLearner {
+		t.Errorf("member = %+v, want voting member %s", mem, m.ID)
+	}
+	if got := len(s.cluster.VotingMembers()); got != 1 {
+		t.Errorf("len(voting members) = %d, want 1", got)
+	}
+	v := semver.Must(semver.NewVersion(version.Version))
+	if wv := (&semver.Version{Major: v.Major, Minor: v.Minor}); s.ClusterVersion() == nil || !s.ClusterVersion().Equal(*wv) {
+		t.Errorf("cluster version = %v, want %v", s.ClusterVersion(), wv)
+	}
+
+	if _, err = s.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}); err != nil {
+		t.Fatalf("put error: %v", err)
+	}
+	resp, err := s.Range(ctx, &pb.RangeRequest{Key: []byte("foo")})
+	if err != nil {
+		t.Fatalf("range error: %v", err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "bar" {
+		t.Errorf("range kvs = %v, want foo=bar", resp.Kvs)
+	}
+
+	s.Stop()
+
+	// the data dir now holds a WAL
+	s, err = NewServer(cfg)
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	if err = s.BootstrapSingleNode(ctx, *m); err != ErrDataDirNotEmpty {
+		t.Errorf("bootstrap of an existing data dir error = %v, want %v", err, ErrDataDirNotEmpty)
+	}
+	s.Start()
+	s.Stop()
+}
+
 func TestStopNotify(t *testing.T) {
 	s := &EtcdServer{
 		lgMu: new(sync.RWMutex),
@@ -1790,6 +2062,46 @@ func TestStopNotify(t *testing.T) {
 	}
 }
 
+func TestAcquireWatchStreamLimit(t *testing.T) {
+	srv := &EtcdServer{
+		lgMu: new(sync.RWMutex),
+		lg:   zap.NewExample(),
+		Cfg:  config.ServerConfig{MaxConcurrentWatchStreams: 3},
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := srv.AcquireWatchStream(); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+	}
+	if err := srv.AcquireWatchStream(); err != ErrTooManyWatchStreams {
+		t.Fatalf("err = %v, want %v", err, ErrTooManyWatchStreams)
+	}
+	if n := srv.WatchStreams(); n != 3 {
+		t.Fatalf("watch streams = %d, want 3", n)
+	}
+
+	srv.ReleaseWatchStream()
+	if err := srv.AcquireWatchStream(); err != nil {
+		t.Fatalf("unexpected error after release %v", err)
+	}
+	if err := srv.AcquireWatchStream(); err != ErrTooManyWatchStreams {
+		t.Fatalf("err = %v, want %v", err, ErrTooManyWatchStreams)
+	}
+}
+
+func TestAcquireWatchStreamUnlimited(t *testing.T) {
+	srv := &EtcdServer{
+		lgMu: new(sync.RWMutex),
+		lg:   zap.NewExample(),
+	}
+	for i := 0; i < 100; i++ {
+		if err := srv.AcquireWatchStream(); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+	}
+}
+
 func TestGetOtherPeerURLs(t *testing.T) {
 	lg := zaptest.NewLogger(t)
 	tests := []struct {
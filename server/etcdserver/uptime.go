@@ -0,0 +1,81 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"encoding/binary"
+	"time"
+
+	"go.etcd.io/etcd/server/v3/mvcc"
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+
+	"go.uber.org/zap"
+)
+
+// markStarted records a successful startup of the server. It increments
+// the restart count persisted in the backend and resets the uptime.
+func (s *EtcdServer) markStarted() {
+	tx := s.be.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(mvcc.MetaBucketName)
+	n := unsafeReadRestartCount(tx) + 1
+	unsafeSaveRestartCount(tx, n)
+	tx.Unlock()
+	s.be.ForceCommit()
+
+	s.restartCount = n
+	s.startTime = time.Now()
+	restartCount.Set(float64(n))
+	s.Logger().Info(
+		"recorded server start",
+		zap.String("local-member-id", s.ID().String()),
+		zap.Uint64("restart-count", n),
+	)
+}
+
+// Uptime returns how long the server has been running since it started.
+func (s *EtcdServer) Uptime() time.Duration {
+	return time.Since(s.startTime)
+}
+
+// RestartCount returns the number of times the member has started on its
+// backend, including the current start.
+func (s *EtcdServer) RestartCount() uint64 {
+	return s.restartCount
+}
+
+// saveRestartCount persists the member's restart count in be, which
+// replaces the count carried by a backend received from the leader.
+func saveRestartCount(be backend.Backend, n uint64) {
+	tx := be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+	tx.UnsafeCreateBucket(mvcc.MetaBucketName)
+	unsafeSaveRestartCount(tx, n)
+}
+
+func unsafeReadRestartCount(tx backend.ReadTx) uint64 {
+	_, vs := tx.UnsafeRange(mvcc.MetaBucketName, mvcc.RestartCountKeyName, nil, 0)
+	if len(vs) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(vs[0])
+}
+
+func unsafeSaveRestartCount(tx backend.BatchTx, n uint64) {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, n)
+	tx.UnsafePut(mvcc.MetaBucketName, mvcc.RestartCountKeyName, bs)
+}
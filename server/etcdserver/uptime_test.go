@@ -0,0 +1,69 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/server/v3/mvcc/backend"
+	betesting "go.etcd.io/etcd/server/v3/mvcc/backend/testing"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRestartCount(t *testing.T) {
+	be, tmpPath := betesting.NewDefaultTmpBackend(t)
+
+	var prev *EtcdServer
+	for i := uint64(1); i <= 3; i++ {
+		srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: zaptest.NewLogger(t), be: be}
+		srv.markStarted()
+
+		if n := srv.RestartCount(); n != i {
+			t.Errorf("#%d: restart count = %d, want %d", i, n, i)
+		}
+		if prev != nil && srv.Uptime() >= prev.Uptime() {
+			t.Errorf("#%d: uptime = %v, want less than the previous server's %v", i, srv.Uptime(), prev.Uptime())
+		}
+		time.Sleep(10 * time.Millisecond)
+		if up := srv.Uptime(); up < 10*time.Millisecond {
+			t.Errorf("#%d: uptime = %v, want >= 10ms", i, up)
+		}
+
+		// restart against the same backend
+		betesting.Close(t, be)
+		be = backend.NewDefaultBackend(tmpPath)
+		prev = srv
+	}
+	betesting.Close(t, be)
+}
+
+func TestSaveRestartCountOverridesSnapshotBackend(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+
+	// a backend received from the leader carries the leader's count
+	saveRestartCount(be, 7)
+	saveRestartCount(be, 2)
+
+	tx := be.BatchTx()
+	tx.Lock()
+	n := unsafeReadRestartCount(tx)
+	tx.Unlock()
+	if n != 2 {
+		t.Errorf("restart count = %d, want 2", n)
+	}
+}
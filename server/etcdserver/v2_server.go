@@ -100,6 +100,9 @@ func (a *reqV2HandlerEtcdServer) processRaftRequest(ctx context.Context, r *Requ
 	}
 	ch := a.s.w.Register(r.ID)
 
+	if a.s.Cfg.AuditProposals {
+		a.s.auditV2Proposal((*pb.Request)(r))
+	}
 	start := time.Now()
 	a.s.r.Propose(ctx, data)
 	proposalsPending.Inc()
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -663,6 +664,10 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 		}
 	}
 
+	if s.Cfg.AuditProposals {
+		s.auditProposal(&r)
+	}
+
 	data, err := r.Marshal()
 	if err != nil {
 		return nil, err
@@ -706,6 +711,33 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 // Watchable returns a watchable interface attached to the etcdserver.
 func (s *EtcdServer) Watchable() mvcc.WatchableKV { return s.KV() }
 
+// AcquireWatchStream registers a new watch stream with the server.
+// It returns ErrTooManyWatchStreams if the number of active streams
+// has reached MaxConcurrentWatchStreams. Every successful call must
+// be paired with ReleaseWatchStream.
+func (s *EtcdServer) AcquireWatchStream() error {
+	limit := int64(s.Cfg.MaxConcurrentWatchStreams)
+	for {
+		n := atomic.LoadInt64(&s.watchStreams)
+		if limit > 0 && n >= limit {
+			return ErrTooManyWatchStreams
+		}
+		if atomic.CompareAndSwapInt64(&s.watchStreams, n, n+1) {
+			watchStreamsActive.Inc()
+			return nil
+		}
+	}
+}
+
+// ReleaseWatchStream unregisters a watch stream acquired by AcquireWatchStream.
+func (s *EtcdServer) ReleaseWatchStream() {
+	atomic.AddInt64(&s.watchStreams, -1)
+	watchStreamsActive.Dec()
+}
+
+// WatchStreams returns the number of active watch streams.
+func (s *EtcdServer) WatchStreams() int64 { return atomic.LoadInt64(&s.watchStreams) }
+
 func (s *EtcdServer) linearizableReadLoop() {
 	for {
 		requestId := s.reqIDGen.Next()
@@ -45,6 +45,13 @@ var (
 	minSnapshotWarningTimeout = 30 * time.Second
 )
 
+const (
+	// AccessPatternSequential hints that the backend mmap is mostly read sequentially.
+	AccessPatternSequential = "sequential"
+	// AccessPatternRandom hints that the backend mmap is mostly read at random.
+	AccessPatternRandom = "random"
+)
+
 type Backend interface {
 	// ReadTx returns a read transaction. It is replaced by ConcurrentReadTx in the main data path, see #10523.
 	ReadTx() ReadTx
@@ -93,6 +100,8 @@ type backend struct {
 	openReadTxN int64
 	// mlock prevents backend database file to be swapped
 	mlock bool
+	// accessPattern is the expected access pattern of the backend mmap
+	accessPattern string
 
 	mu sync.RWMutex
 	db *bolt.DB
@@ -128,6 +137,10 @@ type BackendConfig struct {
 	UnsafeNoFsync bool `json:"unsafe-no-fsync"`
 	// Mlock prevents backend database file to be swapped
 	Mlock bool
+	// AccessPattern is the expected access pattern of the backend mmap,
+	// AccessPatternSequential or AccessPatternRandom. If set, the mmap is
+	// only populated for the initial read. Empty keeps the platform default.
+	AccessPattern string
 
 	// Hooks are getting executed during lifecycle of Backend's transactions.
 	Hooks Hooks
@@ -170,6 +183,7 @@ func newBackend(bcfg BackendConfig) *backend {
 	if err != nil {
 		bcfg.Logger.Panic("failed to open database", zap.String("path", bcfg.Path), zap.Error(err))
 	}
+	applyAccessPattern(bcfg.Logger, db, bcfg.mmapSize(), bcfg.AccessPattern)
 
 	// In future, may want to make buffering optional for low-concurrency systems
 	// or dynamically swap between buffered/non-buffered depending on workload.
@@ -179,6 +193,7 @@ func newBackend(bcfg BackendConfig) *backend {
 		batchInterval: bcfg.BatchInterval,
 		batchLimit:    bcfg.BatchLimit,
 		mlock:         bcfg.Mlock,
+		accessPattern: bcfg.AccessPattern,
 
 		readTx: &readTx{
 			baseReadTx: baseReadTx{
@@ -204,6 +219,30 @@ func newBackend(bcfg BackendConfig) *backend {
 	return b
 }
 
+// applyAccessPattern hints the kernel about the expected access pattern of
+// the db mmap, which covers at least mmapSize bytes. It also stops bolt from
+// populating the mmap again when it is remapped, so MAP_POPULATE only serves
+// the initial recovery read. A remapped region gets the default hint back.
+func applyAccessPattern(lg *zap.Logger, db *bolt.DB, mmapSize int, pattern string) {
+	if pattern == "" {
+		return
+	}
+	db.MmapFlags = 0
+
+	size := mmapSize
+	if fi, err := os.Stat(db.Path()); err == nil && int(fi.Size()) > size {
+		size = int(fi.Size())
+	}
+	if err := madviseAccessPattern(db, size, pattern); err != nil {
+		lg.Warn(
+			"failed to apply backend access pattern",
+			zap.String("path", db.Path()),
+			zap.String("access-pattern", pattern),
+			zap.Error(err),
+		)
+	}
+}
+
 // BatchTx returns the current batch tx in coalescer. The tx can be used for read and
 // write operations. The write result can be retrieved within the same tx immediately.
 // The write result is isolated with other txs until the current one get committed.
@@ -449,6 +488,7 @@ func (b *backend) defrag() error {
 	if err != nil {
 		b.lg.Fatal("failed to open database", zap.String("path", dbp), zap.Error(err))
 	}
+	applyAccessPattern(b.lg, b.db, 0, b.accessPattern)
 	b.batchTx.tx = b.unsafeBegin(true)
 
 	b.readTx.reset()
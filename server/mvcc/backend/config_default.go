@@ -22,3 +22,5 @@ import bolt "go.etcd.io/bbolt"
 var boltOpenOptions *bolt.Options
 
 func (bcfg *BackendConfig) mmapSize() int { return int(bcfg.MmapSize) }
+
+func madviseAccessPattern(*bolt.DB, int, string) error { return nil }
@@ -15,9 +15,11 @@
 package backend
 
 import (
+	"fmt"
 	"syscall"
 
 	bolt "go.etcd.io/bbolt"
+	"golang.org/x/sys/unix"
 )
 
 // syscall.MAP_POPULATE on linux 2.6.23+ does sequential read-ahead
@@ -32,3 +34,26 @@ var boltOpenOptions = &bolt.Options{
 }
 
 func (bcfg *BackendConfig) mmapSize() int { return int(bcfg.MmapSize) }
+
+// madvise applies advice to the mapped region at addr. Tests override it
+// to observe the hints applied to the backend mmap.
+var madvise = func(addr uintptr, length int, advice int) error {
+	// unix.Madvise takes a []byte, but bolt only exposes the mmap address.
+	if _, _, errno := unix.Syscall(unix.SYS_MADVISE, addr, uintptr(length), uintptr(advice)); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func madviseAccessPattern(db *bolt.DB, length int, pattern string) error {
+	var advice int
+	switch pattern {
+	case AccessPatternSequential:
+		advice = unix.MADV_SEQUENTIAL
+	case AccessPatternRandom:
+		advice = unix.MADV_RANDOM
+	default:
+		return fmt.Errorf("unknown access pattern %q", pattern)
+	}
+	return madvise(db.Info().Data, length, advice)
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package backend
+
+import (
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sys/unix"
+)
+
+type madviseCall struct {
+	addr   uintptr
+	length int
+	advice int
+}
+
+func TestBackendAccessPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+
+		wadvice    int
+		wadvised   bool
+		wmmapFlags int
+	}{
+		{"", 0, false, syscall.MAP_POPULATE},
+		{AccessPatternSequential, unix.MADV_SEQUENTIAL, true, 0},
+		{AccessPatternRandom, unix.MADV_RANDOM, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			var calls []madviseCall
+			defer func(f func(uintptr, int, int) error) { madvise = f }(madvise)
+			madvise = func(addr uintptr, length int, advice int) error {
+				calls = append(calls, madviseCall{addr, length, advice})
+				return nil
+			}
+
+			bcfg := DefaultBackendConfig()
+			bcfg.Path = filepath.Join(t.TempDir(), "database")
+			bcfg.Logger = zaptest.NewLogger(t)
+			bcfg.MmapSize = 1024 * 1024
+			bcfg.BatchInterval = time.Hour
+			bcfg.AccessPattern = tt.pattern
+			b := newBackend(bcfg)
+			defer b.Close()
+
+			// the backend must serve reads and writes as usual
+			tx := b.BatchTx()
+			tx.Lock()
+			tx.UnsafeCreateBucket([]byte("test"))
+			tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+			tx.Unlock()
+			b.ForceCommit()
+			rtx := b.ReadTx()
+			rtx.RLock()
+			_, vals := rtx.UnsafeRange([]byte("test"), []byte("foo"), nil, 0)
+			rtx.RUnlock()
+			if len(vals) != 1 || string(vals[0]) != "bar" {
+				t.Fatalf("vals = %q, want [bar]", vals)
+			}
+
+			if b.db.MmapFlags != tt.wmmapFlags {
+				t.Errorf("mmap flags = %d, want %d", b.db.MmapFlags, tt.wmmapFlags)
+			}
+			if !tt.wadvised {
+				if len(calls) != 0 {
+					t.Fatalf("madvise calls = %+v, want none", calls)
+				}
+				return
+			}
+			if len(calls) != 1 {
+				t.Fatalf("madvise calls = %+v, want 1", calls)
+			}
+			c := calls[0]
+			if c.advice != tt.wadvice {
+				t.Errorf("advice = %d, want %d", c.advice, tt.wadvice)
+			}
+			if c.addr != b.db.Info().Data || c.length < int(bcfg.MmapSize) {
+				t.Errorf("madvise region = (%#x, %d), want (%#x, >= %d)", c.addr, c.length, b.db.Info().Data, bcfg.MmapSize)
+			}
+		})
+	}
+}
@@ -25,3 +25,5 @@ var boltOpenOptions *bolt.Options = nil
 // mmap size for the file, instead of growing it. So, force 0.
 
 func (bcfg *BackendConfig) mmapSize() int { return 0 }
+
+func madviseAccessPattern(*bolt.DB, int, string) error { return nil }
@@ -40,6 +40,10 @@ var (
 	scheduledCompactKeyName = []byte("scheduledCompactRev")
 	finishedCompactKeyName  = []byte("finishedCompactRev")
 
+	// RestartCountKeyName is the meta bucket key of the number of times
+	// the member has started on the backend. It is local to the member.
+	RestartCountKeyName = []byte("restartCount")
+
 	ErrCompacted = errors.New("mvcc: required revision has been compacted")
 	ErrFutureRev = errors.New("mvcc: required revision is a future revision")
 )
@@ -306,6 +310,8 @@ func init() {
 		// is not controllable by the user.
 		{Bucket: string(MetaBucketName), Key: string(cindex.ConsistentIndexKeyName)}: {},
 		{Bucket: string(MetaBucketName), Key: string(cindex.TermKeyName)}:            {},
+		// restart count is local to the member.
+		{Bucket: string(MetaBucketName), Key: string(RestartCountKeyName)}: {},
 	}
 }
 